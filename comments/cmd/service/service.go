@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io"
@@ -9,10 +10,12 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	endpoint1 "github.com/go-kit/kit/endpoint"
 	log "github.com/go-kit/kit/log"
 	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	grpc2 "github.com/go-kit/kit/transport/grpc"
 	"github.com/hashicorp/vault/api"
 	group "github.com/oklog/oklog/pkg/group"
 	opentracinggo "github.com/opentracing/opentracing-go"
@@ -30,6 +33,11 @@ import (
 	grpc "github.com/emadghaffari/kit-blog/comments/pkg/grpc"
 	pb "github.com/emadghaffari/kit-blog/comments/pkg/grpc/pb"
 	service "github.com/emadghaffari/kit-blog/comments/pkg/service"
+	"github.com/emadghaffari/kit-blog/users/pkg/auth"
+	"github.com/emadghaffari/kit-blog/users/pkg/events"
+	us "github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
+	"github.com/emadghaffari/kit-blog/users/pkg/keys"
+	"github.com/emadghaffari/kit-blog/users/pkg/tokens"
 )
 
 var tracer opentracinggo.Tracer
@@ -48,6 +56,8 @@ var thriftFramed = fs.Bool("thrift-framed", false, "true to enable framing")
 var zipkinURL = fs.String("zipkin-url", "", "Enable Zipkin tracing via a collector URL e.g. http://localhost:9411/api/v1/spans")
 var lightstepToken = fs.String("lightstep-token", "", "Enable LightStep tracing via a LightStep access token")
 var appdashAddr = fs.String("appdash-addr", "", "Enable Appdash tracing via an Appdash server host:port")
+var digestWindow = fs.Duration("digest-window", 5*time.Minute, "window in which comment notifications for an author are sent together")
+var deletedUserContent = fs.String("deleted-user-content", events.PolicyAnonymize, "what happens to the comments of a deleted user: anonymize or delete")
 
 // Run func
 func Run() {
@@ -107,6 +117,9 @@ func Run() {
 func initGRPCHandler(endpoints endpoint.Endpoints, g *group.Group) {
 	options := defaultGRPCOptions(logger, tracer)
 	// Add your GRPC options here
+	for m := range options {
+		options[m] = append(options[m], grpc2.ServerBefore(auth.GRPCToContext()))
+	}
 
 	grpcServer := grpc.NewGRPCServer(endpoints, options)
 	grpcListener, err := net.Listen("tcp", *grpcAddr)
@@ -141,6 +154,13 @@ func getEndpointMiddleware(logger log.Logger) (mw map[string][]endpoint1.Middlew
 	}, []string{"method", "success"})
 	addDefaultEndpointMiddleware(logger, duration, mw)
 	// Add you endpoint middleware here
+	authn := initAuth()
+	// called by the users service for the data export of a user
+	mw["ExportUser"] = append(mw["ExportUser"], auth.Middleware(auth.WithService(authn, config.Confs.Service.Key), auth.AnyRole(auth.RoleService)))
+	for _, m := range []string{"Store", "Update", "React", "Unreact"} {
+		mw[m] = append(mw[m], auth.Middleware(authn, auth.Scoped(auth.ScopeCommentsWrite)))
+	}
+	mw["Moderate"] = append(mw["Moderate"], auth.Middleware(authn, auth.All(auth.AnyRole(auth.RoleModerator), auth.Scoped(auth.ScopeCommentsWrite))))
 
 	return
 }
@@ -183,6 +203,14 @@ func initVault() error {
 	config.Confs.Comments.ThriftAddr = *thriftAddr
 	config.Confs.Comments.Host = "localhost"
 	config.Confs.Users.Path = "blog/users"
+	config.Confs.Posts.Path = "blog/posts"
+	config.Confs.Notifs.Path = "blog/notificator"
+	config.Confs.Service.Path = "blog/service"
+	config.Confs.Digest.Window = *digestWindow
+	config.Confs.DeletedUsers.Policy = *deletedUserContent
+	if !events.Policies[config.Confs.DeletedUsers.Policy] {
+		return fmt.Errorf("unknown deleted user content policy %q", config.Confs.DeletedUsers.Policy)
+	}
 
 	confs := &api.Config{
 		Address: config.Confs.Vault.Address,
@@ -202,7 +230,38 @@ func initVault() error {
 		logger.Log(err)
 		return err
 	}
-	config.Confs.Users.GrpcAddr = users.Data["grpc"].(string)
+	config.Confs.Users.Host = users.Data["grpc"].(string)
+	config.Confs.Users.JWKS, _ = users.Data["jwks"].(string)
+	config.Confs.Users.Issuer, _ = users.Data["issuer"].(string)
+	config.Confs.Users.Audience, _ = users.Data["audience"].(string)
+
+	// Read posts path
+	posts, err := c.Read(config.Confs.Posts.Path)
+	if err != nil {
+		logger.Log(err)
+		return err
+	}
+	config.Confs.Posts.Host = posts.Data["grpc"].(string)
+
+	// Read notif path
+	notifs, err := c.Read(config.Confs.Notifs.Path)
+	if err != nil {
+		logger.Log(err)
+		return err
+	}
+	config.Confs.Notifs.Host = notifs.Data["grpc"].(string)
+
+	// Read the service key, digests need it to get the contact data of users
+	// and to send notifications
+	svc, err := c.Read(config.Confs.Service.Path)
+	if err != nil {
+		logger.Log(err)
+		return err
+	}
+	if svc == nil {
+		return fmt.Errorf("no service key at %s", config.Confs.Service.Path)
+	}
+	config.Confs.Service.Key, _ = svc.Data["key"].(string)
 
 	// Write Comments Path
 	_, err = c.Write(config.Confs.Comments.Path, map[string]interface{}{
@@ -218,3 +277,26 @@ func initVault() error {
 
 	return nil
 }
+
+// initAuth returns an Authenticator checking access tokens with the JWKS of
+// the users service and asking it about API keys, or about every token when
+// users did not publish a JWKS.
+func initAuth() auth.Authenticator {
+	conn, err := grpc1.Dial(config.Confs.Users.Host, grpc1.WithInsecure())
+	if err != nil {
+		logger.Log("during", "Dial", "users", "err", err)
+		return auth.AuthenticatorFunc(func(context.Context, string) (auth.Identity, error) {
+			return auth.Identity{}, auth.ErrUnauthenticated
+		})
+	}
+	authn := auth.NewClient(us.NewUsersClient(conn))
+	if config.Confs.Users.JWKS == "" {
+		return authn
+	}
+	return auth.WithLocal(auth.NewLocal(&tokens.Parser{
+		Keys:     keys.NewRemote(config.Confs.Users.JWKS),
+		Issuer:   config.Confs.Users.Issuer,
+		Audience: config.Confs.Users.Audience,
+		Skew:     30 * time.Second,
+	}), authn)
+}
@@ -20,9 +20,13 @@ func createService(endpoints endpoint.Endpoints) (g *group.Group) {
 }
 func defaultGRPCOptions(logger log.Logger, tracer opentracinggo.Tracer) map[string][]grpc.ServerOption {
 	options := map[string][]grpc.ServerOption{
-		"List":   {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "List", logger))},
-		"Store":  {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Store", logger))},
-		"Update": {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Update", logger))},
+		"ExportUser": {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "ExportUser", logger))},
+		"List":       {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "List", logger))},
+		"Moderate":   {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Moderate", logger))},
+		"React":      {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "React", logger))},
+		"Store":      {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Store", logger))},
+		"Unreact":    {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Unreact", logger))},
+		"Update":     {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Update", logger))},
 	}
 	return options
 }
@@ -30,12 +34,16 @@ func addDefaultEndpointMiddleware(logger log.Logger, duration *prometheus.Summar
 	mw["Store"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Store")), endpoint.InstrumentingMiddleware(duration.With("method", "Store"))}
 	mw["Update"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Update")), endpoint.InstrumentingMiddleware(duration.With("method", "Update"))}
 	mw["List"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "List")), endpoint.InstrumentingMiddleware(duration.With("method", "List"))}
+	mw["React"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "React")), endpoint.InstrumentingMiddleware(duration.With("method", "React"))}
+	mw["Unreact"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Unreact")), endpoint.InstrumentingMiddleware(duration.With("method", "Unreact"))}
+	mw["Moderate"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Moderate")), endpoint.InstrumentingMiddleware(duration.With("method", "Moderate"))}
+	mw["ExportUser"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "ExportUser")), endpoint.InstrumentingMiddleware(duration.With("method", "ExportUser"))}
 }
 func addDefaultServiceMiddleware(logger log.Logger, mw []service.Middleware) []service.Middleware {
 	return append(mw, service.LoggingMiddleware(logger))
 }
 func addEndpointMiddlewareToAllMethods(mw map[string][]endpoint1.Middleware, m endpoint1.Middleware) {
-	methods := []string{"Store", "Update", "List"}
+	methods := []string{"Store", "Update", "List", "React", "Unreact", "Moderate", "ExportUser"}
 	for _, v := range methods {
 		mw[v] = append(mw[v], m)
 	}
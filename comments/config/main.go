@@ -1,6 +1,10 @@
 package config
 
-import "github.com/hashicorp/vault/api"
+import (
+	"time"
+
+	"github.com/hashicorp/vault/api"
+)
 
 // Confs var
 var Confs configs
@@ -31,6 +35,28 @@ type (
 			HTTPAddr   string
 			GrpcAddr   string
 			ThriftAddr string
+			// JWKS, Issuer and Audience check access tokens without a call
+			// to users, which is asked about API keys only
+			JWKS     string
+			Issuer   string
+			Audience string
+		}
+		Notifs struct {
+			Host string
+			Path string
+		}
+		Digest struct {
+			Window time.Duration
+		}
+		DeletedUsers struct {
+			// Policy is events.PolicyAnonymize or events.PolicyDelete
+			Policy string
+		}
+		Service struct {
+			Path string
+			// Key is shared by the blog services to call the methods
+			// users may not, like GetPrivate
+			Key string
 		}
 		Vault struct {
 			Address string
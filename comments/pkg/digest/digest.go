@@ -0,0 +1,75 @@
+package digest
+
+import (
+	"sync"
+	"time"
+)
+
+// maxEvents bounds what is kept per recipient; a busy thread still counts
+// every comment but only the latest ones are kept for the message.
+const maxEvents = 10
+
+// Event is one comment a recipient should hear about.
+type Event struct {
+	RecipientID string
+	CommenterID string
+	PostID      string
+	CommentID   string
+	Title       string
+	Body        string
+	Reply       bool
+}
+
+// Sender delivers what was collected for a recipient in one window. count
+// is the number of events, events holds the latest of them.
+type Sender func(recipientID string, count int, events []Event)
+
+type batch struct {
+	count  int
+	events []Event
+}
+
+// Digester collects events per recipient and hands them to the Sender once
+// per window: the first event of a recipient opens a window, and everything
+// arriving until it closes goes out together.
+type Digester struct {
+	window time.Duration
+	send   Sender
+
+	mu      sync.Mutex
+	pending map[string]*batch
+}
+
+// New returns a Digester sending through send every window.
+func New(window time.Duration, send Sender) *Digester {
+	return &Digester{window: window, send: send, pending: map[string]*batch{}}
+}
+
+// Add queues e for its recipient.
+func (d *Digester) Add(e Event) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	b, ok := d.pending[e.RecipientID]
+	if !ok {
+		b = &batch{}
+		d.pending[e.RecipientID] = b
+		time.AfterFunc(d.window, func() { d.flush(e.RecipientID) })
+	}
+	b.count++
+	b.events = append(b.events, e)
+	if len(b.events) > maxEvents {
+		b.events = b.events[len(b.events)-maxEvents:]
+	}
+}
+
+func (d *Digester) flush(recipientID string) {
+	d.mu.Lock()
+	b := d.pending[recipientID]
+	delete(d.pending, recipientID)
+	d.mu.Unlock()
+
+	if b != nil {
+		d.send(recipientID, b.count, b.events)
+	}
+}
@@ -67,23 +67,27 @@ func (r UpdateResponse) Failed() error {
 
 // ListRequest collects the request parameters for the List method.
 type ListRequest struct {
-	PostID string `json:"post_id"`
+	Q service.ListQuery `json:"q"`
 }
 
 // ListResponse collects the response parameters for the List method.
 type ListResponse struct {
-	CMS []*pb.Comment `json:"cms"`
-	Err error         `json:"err"`
+	CMS   []*pb.Comment `json:"cms"`
+	Next  string        `json:"next"`
+	Total int64         `json:"total"`
+	Err   error         `json:"err"`
 }
 
 // MakeListEndpoint returns an endpoint that invokes List on the service.
 func MakeListEndpoint(s service.CommentsService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(ListRequest)
-		cms, err := s.List(ctx, req.PostID)
+		cms, next, total, err := s.List(ctx, req.Q)
 		return ListResponse{
-			CMS: cms,
-			Err: err,
+			CMS:   cms,
+			Next:  next,
+			Total: total,
+			Err:   err,
 		}, nil
 	}
 }
@@ -93,6 +97,119 @@ func (r ListResponse) Failed() error {
 	return r.Err
 }
 
+// ReactRequest collects the request parameters for the React method.
+type ReactRequest struct {
+	UserID    string `json:"user_id"`
+	CommentID string `json:"comment_id"`
+}
+
+// ReactResponse collects the response parameters for the React method.
+type ReactResponse struct {
+	Likes int64 `json:"likes"`
+	Err   error `json:"err"`
+}
+
+// MakeReactEndpoint returns an endpoint that invokes React on the service.
+func MakeReactEndpoint(s service.CommentsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ReactRequest)
+		likes, err := s.React(ctx, req.UserID, req.CommentID)
+		return ReactResponse{
+			Likes: likes,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ReactResponse) Failed() error {
+	return r.Err
+}
+
+// UnreactRequest collects the request parameters for the Unreact method.
+type UnreactRequest struct {
+	UserID    string `json:"user_id"`
+	CommentID string `json:"comment_id"`
+}
+
+// UnreactResponse collects the response parameters for the Unreact method.
+type UnreactResponse struct {
+	Likes int64 `json:"likes"`
+	Err   error `json:"err"`
+}
+
+// MakeUnreactEndpoint returns an endpoint that invokes Unreact on the service.
+func MakeUnreactEndpoint(s service.CommentsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UnreactRequest)
+		likes, err := s.Unreact(ctx, req.UserID, req.CommentID)
+		return UnreactResponse{
+			Likes: likes,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r UnreactResponse) Failed() error {
+	return r.Err
+}
+
+// ModerateRequest collects the request parameters for the Moderate method.
+type ModerateRequest struct {
+	CommentID string `json:"comment_id"`
+	Action    string `json:"action"`
+}
+
+// ModerateResponse collects the response parameters for the Moderate method.
+type ModerateResponse struct {
+	Err error `json:"err"`
+}
+
+// MakeModerateEndpoint returns an endpoint that invokes Moderate on the service.
+func MakeModerateEndpoint(s service.CommentsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ModerateRequest)
+		err := s.Moderate(ctx, req.CommentID, req.Action)
+		return ModerateResponse{
+			Err: err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ModerateResponse) Failed() error {
+	return r.Err
+}
+
+// ExportUserRequest collects the request parameters for the ExportUser method.
+type ExportUserRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// ExportUserResponse collects the response parameters for the ExportUser method.
+type ExportUserResponse struct {
+	Data []byte `json:"data"`
+	Err  error  `json:"err"`
+}
+
+// MakeExportUserEndpoint returns an endpoint that invokes ExportUser on the service.
+func MakeExportUserEndpoint(s service.CommentsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ExportUserRequest)
+		data, err := s.ExportUser(ctx, req.UserID)
+		return ExportUserResponse{
+			Data: data,
+			Err:  err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ExportUserResponse) Failed() error {
+	return r.Err
+}
+
 // Failure is an interface that should be implemented by response types.
 // Response encoders can check if responses are Failer, and if so they've
 // failed, and if so encode them using a separate write path based on the error.
@@ -121,11 +238,51 @@ func (e Endpoints) Update(ctx context.Context, cm service.Comment) (id string, e
 }
 
 // List implements Service. Primarily useful in a client.
-func (e Endpoints) List(ctx context.Context, postID string) (CMS []*pb.Comment, err error) {
-	request := ListRequest{PostID: postID}
+func (e Endpoints) List(ctx context.Context, q service.ListQuery) (CMS []*pb.Comment, next string, total int64, err error) {
+	request := ListRequest{Q: q}
 	response, err := e.ListEndpoint(ctx, request)
 	if err != nil {
 		return
 	}
-	return response.(ListResponse).CMS, response.(ListResponse).Err
+	return response.(ListResponse).CMS, response.(ListResponse).Next, response.(ListResponse).Total, response.(ListResponse).Err
+}
+
+// React implements Service. Primarily useful in a client.
+func (e Endpoints) React(ctx context.Context, userID string, commentID string) (likes int64, err error) {
+	request := ReactRequest{UserID: userID, CommentID: commentID}
+	response, err := e.ReactEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ReactResponse).Likes, response.(ReactResponse).Err
+}
+
+// Unreact implements Service. Primarily useful in a client.
+func (e Endpoints) Unreact(ctx context.Context, userID string, commentID string) (likes int64, err error) {
+	request := UnreactRequest{UserID: userID, CommentID: commentID}
+	response, err := e.UnreactEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(UnreactResponse).Likes, response.(UnreactResponse).Err
+}
+
+// Moderate implements Service. Primarily useful in a client.
+func (e Endpoints) Moderate(ctx context.Context, commentID string, action string) (err error) {
+	request := ModerateRequest{CommentID: commentID, Action: action}
+	response, err := e.ModerateEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ModerateResponse).Err
+}
+
+// ExportUser implements Service. Primarily useful in a client.
+func (e Endpoints) ExportUser(ctx context.Context, userID string) (data []byte, err error) {
+	request := ExportUserRequest{UserID: userID}
+	response, err := e.ExportUserEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ExportUserResponse).Data, response.(ExportUserResponse).Err
 }
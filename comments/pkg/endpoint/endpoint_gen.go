@@ -10,18 +10,26 @@ import (
 // meant to be used as a helper struct, to collect all of the endpoints into a
 // single parameter.
 type Endpoints struct {
-	StoreEndpoint  endpoint.Endpoint
-	UpdateEndpoint endpoint.Endpoint
-	ListEndpoint   endpoint.Endpoint
+	StoreEndpoint      endpoint.Endpoint
+	UpdateEndpoint     endpoint.Endpoint
+	ListEndpoint       endpoint.Endpoint
+	ReactEndpoint      endpoint.Endpoint
+	UnreactEndpoint    endpoint.Endpoint
+	ModerateEndpoint   endpoint.Endpoint
+	ExportUserEndpoint endpoint.Endpoint
 }
 
 // New returns a Endpoints struct that wraps the provided service, and wires in all of the
 // expected endpoint middlewares
 func New(s service.CommentsService, mdw map[string][]endpoint.Middleware) Endpoints {
 	eps := Endpoints{
-		ListEndpoint:   MakeListEndpoint(s),
-		StoreEndpoint:  MakeStoreEndpoint(s),
-		UpdateEndpoint: MakeUpdateEndpoint(s),
+		ExportUserEndpoint: MakeExportUserEndpoint(s),
+		ListEndpoint:       MakeListEndpoint(s),
+		ModerateEndpoint:   MakeModerateEndpoint(s),
+		ReactEndpoint:      MakeReactEndpoint(s),
+		StoreEndpoint:      MakeStoreEndpoint(s),
+		UnreactEndpoint:    MakeUnreactEndpoint(s),
+		UpdateEndpoint:     MakeUpdateEndpoint(s),
 	}
 	for _, m := range mdw["Store"] {
 		eps.StoreEndpoint = m(eps.StoreEndpoint)
@@ -32,5 +40,17 @@ func New(s service.CommentsService, mdw map[string][]endpoint.Middleware) Endpoi
 	for _, m := range mdw["List"] {
 		eps.ListEndpoint = m(eps.ListEndpoint)
 	}
+	for _, m := range mdw["React"] {
+		eps.ReactEndpoint = m(eps.ReactEndpoint)
+	}
+	for _, m := range mdw["Unreact"] {
+		eps.UnreactEndpoint = m(eps.UnreactEndpoint)
+	}
+	for _, m := range mdw["Moderate"] {
+		eps.ModerateEndpoint = m(eps.ModerateEndpoint)
+	}
+	for _, m := range mdw["ExportUser"] {
+		eps.ExportUserEndpoint = m(eps.ExportUserEndpoint)
+	}
 	return eps
 }
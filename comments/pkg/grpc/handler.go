@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"strings"
 
 	grpc "github.com/go-kit/kit/transport/grpc"
 	context1 "golang.org/x/net/context"
@@ -20,7 +21,7 @@ func makeStoreHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption)
 // gRPC request to a user-domain Store request.
 func decodeStoreRequest(_ context.Context, r interface{}) (interface{}, error) {
 	req := r.(*pb.StoreRequest)
-	return endpoint.StoreRequest{Cm: service.Comment{UserID: req.UserID, PostID: req.PostID, Title: req.Title, Body: req.Body}}, nil
+	return endpoint.StoreRequest{Cm: service.Comment{UserID: req.UserID, PostID: req.PostID, ParentID: req.ParentID, Title: req.Title, Body: req.Body}}, nil
 }
 
 // encodeStoreResponse is a transport/grpc.EncodeResponseFunc that converts
@@ -79,7 +80,14 @@ func makeListHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption)
 // gRPC request to a user-domain List request.
 func decodeListRequest(_ context.Context, r interface{}) (interface{}, error) {
 	req := r.(*pb.ListRequest)
-	return endpoint.ListRequest{PostID: req.PostID}, nil
+	return endpoint.ListRequest{
+		Q: service.ListQuery{
+			PostID: req.PostID,
+			Limit:  req.Limit,
+			Cursor: req.Cursor,
+			Sort:   strings.ToLower(req.Sort.String()),
+		},
+	}, nil
 
 }
 
@@ -90,7 +98,7 @@ func encodeListResponse(_ context.Context, r interface{}) (interface{}, error) {
 	if resp.Err != nil {
 		return &pb.ListReply{Comments: []*pb.Comment{}, Status: pb.ListReply_Fail.String()}, resp.Err
 	}
-	return &pb.ListReply{Comments: resp.CMS, Status: pb.ListReply_Success.String()}, nil
+	return &pb.ListReply{Comments: resp.CMS, NextCursor: resp.Next, Total: resp.Total, Status: pb.ListReply_Success.String()}, nil
 }
 func (g *grpcServer) List(ctx context1.Context, req *pb.ListRequest) (*pb.ListReply, error) {
 	_, rep, err := g.list.ServeGRPC(ctx, req)
@@ -99,3 +107,114 @@ func (g *grpcServer) List(ctx context1.Context, req *pb.ListRequest) (*pb.ListRe
 	}
 	return rep.(*pb.ListReply), nil
 }
+
+// makeReactHandler creates the handler logic
+func makeReactHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ReactEndpoint, decodeReactRequest, encodeReactResponse, options...)
+}
+
+// decodeReactResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain React request.
+func decodeReactRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ReactRequest)
+	return endpoint.ReactRequest{UserID: req.UserID, CommentID: req.Id}, nil
+}
+
+// encodeReactResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeReactResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ReactResponse)
+	if resp.Err != nil {
+		return &pb.ReactReply{Likes: 0, Status: pb.ReactReply_Fail.String()}, resp.Err
+	}
+	return &pb.ReactReply{Likes: resp.Likes, Status: pb.ReactReply_Success.String()}, nil
+}
+func (g *grpcServer) React(ctx context1.Context, req *pb.ReactRequest) (*pb.ReactReply, error) {
+	_, rep, err := g.react.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ReactReply), nil
+}
+
+// makeUnreactHandler creates the handler logic
+func makeUnreactHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.UnreactEndpoint, decodeUnreactRequest, encodeUnreactResponse, options...)
+}
+
+// decodeUnreactResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain Unreact request.
+func decodeUnreactRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.UnreactRequest)
+	return endpoint.UnreactRequest{UserID: req.UserID, CommentID: req.Id}, nil
+}
+
+// encodeUnreactResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeUnreactResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.UnreactResponse)
+	if resp.Err != nil {
+		return &pb.UnreactReply{Likes: 0, Status: pb.UnreactReply_Fail.String()}, resp.Err
+	}
+	return &pb.UnreactReply{Likes: resp.Likes, Status: pb.UnreactReply_Success.String()}, nil
+}
+func (g *grpcServer) Unreact(ctx context1.Context, req *pb.UnreactRequest) (*pb.UnreactReply, error) {
+	_, rep, err := g.unreact.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UnreactReply), nil
+}
+
+// makeModerateHandler creates the handler logic
+func makeModerateHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ModerateEndpoint, decodeModerateRequest, encodeModerateResponse, options...)
+}
+
+// decodeModerateResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain Moderate request.
+func decodeModerateRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ModerateRequest)
+	return endpoint.ModerateRequest{CommentID: req.Id, Action: req.Action}, nil
+}
+
+// encodeModerateResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeModerateResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ModerateResponse)
+	if resp.Err != nil {
+		return &pb.ModerateReply{Status: pb.ModerateReply_Fail.String()}, resp.Err
+	}
+	return &pb.ModerateReply{Status: pb.ModerateReply_Success.String()}, nil
+}
+func (g *grpcServer) Moderate(ctx context1.Context, req *pb.ModerateRequest) (*pb.ModerateReply, error) {
+	_, rep, err := g.moderate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ModerateReply), nil
+}
+
+func makeExportUserHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ExportUserEndpoint, decodeExportUserRequest, encodeExportUserResponse, options...)
+}
+
+func decodeExportUserRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ExportUserRequest)
+	return endpoint.ExportUserRequest{UserID: req.UserID}, nil
+}
+
+func encodeExportUserResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ExportUserResponse)
+	if resp.Err != nil {
+		return &pb.ExportUserReply{Status: pb.ExportUserReply_Fail.String()}, resp.Err
+	}
+	return &pb.ExportUserReply{Data: resp.Data, Status: pb.ExportUserReply_Success.String()}, nil
+}
+func (g *grpcServer) ExportUser(ctx context1.Context, req *pb.ExportUserRequest) (*pb.ExportUserReply, error) {
+	_, rep, err := g.exportUser.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ExportUserReply), nil
+}
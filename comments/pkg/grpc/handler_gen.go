@@ -9,15 +9,23 @@ import (
 
 // NewGRPCServer makes a set of endpoints available as a gRPC AddServer
 type grpcServer struct {
-	store  grpc.Handler
-	update grpc.Handler
-	list   grpc.Handler
+	store      grpc.Handler
+	update     grpc.Handler
+	list       grpc.Handler
+	react      grpc.Handler
+	unreact    grpc.Handler
+	moderate   grpc.Handler
+	exportUser grpc.Handler
 }
 
 func NewGRPCServer(endpoints endpoint.Endpoints, options map[string][]grpc.ServerOption) pb.CommentsServer {
 	return &grpcServer{
-		list:   makeListHandler(endpoints, options["List"]),
-		store:  makeStoreHandler(endpoints, options["Store"]),
-		update: makeUpdateHandler(endpoints, options["Update"]),
+		exportUser: makeExportUserHandler(endpoints, options["ExportUser"]),
+		list:       makeListHandler(endpoints, options["List"]),
+		moderate:   makeModerateHandler(endpoints, options["Moderate"]),
+		react:      makeReactHandler(endpoints, options["React"]),
+		store:      makeStoreHandler(endpoints, options["Store"]),
+		unreact:    makeUnreactHandler(endpoints, options["Unreact"]),
+		update:     makeUpdateHandler(endpoints, options["Update"]),
 	}
 }
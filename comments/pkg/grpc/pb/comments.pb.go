@@ -121,6 +121,55 @@ func (UpdateReply_ReplyType) EnumDescriptor() ([]byte, []int) {
 	return file_comments_proto_rawDescGZIP(), []int{4, 0}
 }
 
+type ListRequest_SortType int32
+
+const (
+	ListRequest_Oldest ListRequest_SortType = 0
+	ListRequest_Newest ListRequest_SortType = 1
+	ListRequest_Top    ListRequest_SortType = 2
+)
+
+// Enum value maps for ListRequest_SortType.
+var (
+	ListRequest_SortType_name = map[int32]string{
+		0: "Oldest",
+		1: "Newest",
+		2: "Top",
+	}
+	ListRequest_SortType_value = map[string]int32{
+		"Oldest": 0,
+		"Newest": 1,
+		"Top":    2,
+	}
+)
+
+func (x ListRequest_SortType) Enum() *ListRequest_SortType {
+	p := new(ListRequest_SortType)
+	*p = x
+	return p
+}
+
+func (x ListRequest_SortType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListRequest_SortType) Descriptor() protoreflect.EnumDescriptor {
+	return file_comments_proto_enumTypes[2].Descriptor()
+}
+
+func (ListRequest_SortType) Type() protoreflect.EnumType {
+	return &file_comments_proto_enumTypes[2]
+}
+
+func (x ListRequest_SortType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListRequest_SortType.Descriptor instead.
+func (ListRequest_SortType) EnumDescriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type ListReply_ReplyType int32
 
 const (
@@ -151,11 +200,11 @@ func (x ListReply_ReplyType) String() string {
 }
 
 func (ListReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_comments_proto_enumTypes[2].Descriptor()
+	return file_comments_proto_enumTypes[3].Descriptor()
 }
 
 func (ListReply_ReplyType) Type() protoreflect.EnumType {
-	return &file_comments_proto_enumTypes[2]
+	return &file_comments_proto_enumTypes[3]
 }
 
 func (x ListReply_ReplyType) Number() protoreflect.EnumNumber {
@@ -167,6 +216,190 @@ func (ListReply_ReplyType) EnumDescriptor() ([]byte, []int) {
 	return file_comments_proto_rawDescGZIP(), []int{6, 0}
 }
 
+type ReactReply_ReplyType int32
+
+const (
+	ReactReply_Success ReactReply_ReplyType = 0
+	ReactReply_Fail    ReactReply_ReplyType = 1
+)
+
+// Enum value maps for ReactReply_ReplyType.
+var (
+	ReactReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ReactReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ReactReply_ReplyType) Enum() *ReactReply_ReplyType {
+	p := new(ReactReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ReactReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReactReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_comments_proto_enumTypes[4].Descriptor()
+}
+
+func (ReactReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_comments_proto_enumTypes[4]
+}
+
+func (x ReactReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReactReply_ReplyType.Descriptor instead.
+func (ReactReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type UnreactReply_ReplyType int32
+
+const (
+	UnreactReply_Success UnreactReply_ReplyType = 0
+	UnreactReply_Fail    UnreactReply_ReplyType = 1
+)
+
+// Enum value maps for UnreactReply_ReplyType.
+var (
+	UnreactReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	UnreactReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x UnreactReply_ReplyType) Enum() *UnreactReply_ReplyType {
+	p := new(UnreactReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x UnreactReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnreactReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_comments_proto_enumTypes[5].Descriptor()
+}
+
+func (UnreactReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_comments_proto_enumTypes[5]
+}
+
+func (x UnreactReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnreactReply_ReplyType.Descriptor instead.
+func (UnreactReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type ModerateReply_ReplyType int32
+
+const (
+	ModerateReply_Success ModerateReply_ReplyType = 0
+	ModerateReply_Fail    ModerateReply_ReplyType = 1
+)
+
+// Enum value maps for ModerateReply_ReplyType.
+var (
+	ModerateReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ModerateReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ModerateReply_ReplyType) Enum() *ModerateReply_ReplyType {
+	p := new(ModerateReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ModerateReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerateReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_comments_proto_enumTypes[6].Descriptor()
+}
+
+func (ModerateReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_comments_proto_enumTypes[6]
+}
+
+func (x ModerateReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerateReply_ReplyType.Descriptor instead.
+func (ModerateReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type ExportUserReply_ReplyType int32
+
+const (
+	ExportUserReply_Success ExportUserReply_ReplyType = 0
+	ExportUserReply_Fail    ExportUserReply_ReplyType = 1
+)
+
+// Enum value maps for ExportUserReply_ReplyType.
+var (
+	ExportUserReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ExportUserReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ExportUserReply_ReplyType) Enum() *ExportUserReply_ReplyType {
+	p := new(ExportUserReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ExportUserReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportUserReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_comments_proto_enumTypes[7].Descriptor()
+}
+
+func (ExportUserReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_comments_proto_enumTypes[7]
+}
+
+func (x ExportUserReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportUserReply_ReplyType.Descriptor instead.
+func (ExportUserReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{14, 0}
+}
+
 type Comment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -179,9 +412,15 @@ type Comment struct {
 	// Types that are assignable to Username:
 	//	*Comment_Name
 	Username isComment_Username `protobuf_oneof:"username"`
+	// useremail is no longer set, profiles do not share contact data
+	//
 	// Types that are assignable to Useremail:
 	//	*Comment_Email
 	Useremail isComment_Useremail `protobuf_oneof:"useremail"`
+	Id        string              `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string              `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Likes     int64               `protobuf:"varint,9,opt,name=likes,proto3" json:"likes,omitempty"`
+	ParentID  string              `protobuf:"bytes,10,opt,name=parentID,proto3" json:"parentID,omitempty"`
 }
 
 func (x *Comment) Reset() {
@@ -272,6 +511,34 @@ func (x *Comment) GetEmail() string {
 	return ""
 }
 
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *Comment) GetParentID() string {
+	if x != nil {
+		return x.ParentID
+	}
+	return ""
+}
+
 type isComment_Username interface {
 	isComment_Username()
 }
@@ -297,10 +564,11 @@ type StoreRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
-	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	Title  string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Body   string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	PostID   string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	UserID   string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Title    string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body     string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	ParentID string `protobuf:"bytes,5,opt,name=parentID,proto3" json:"parentID,omitempty"`
 }
 
 func (x *StoreRequest) Reset() {
@@ -363,6 +631,13 @@ func (x *StoreRequest) GetBody() string {
 	return ""
 }
 
+func (x *StoreRequest) GetParentID() string {
+	if x != nil {
+		return x.ParentID
+	}
+	return ""
+}
+
 type StoreReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -557,7 +832,10 @@ type ListRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	PostID string               `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	Limit  int64                `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string               `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Sort   ListRequest_SortType `protobuf:"varint,4,opt,name=sort,proto3,enum=pb.ListRequest_SortType" json:"sort,omitempty"`
 }
 
 func (x *ListRequest) Reset() {
@@ -599,13 +877,36 @@ func (x *ListRequest) GetPostID() string {
 	return ""
 }
 
+func (x *ListRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRequest) GetSort() ListRequest_SortType {
+	if x != nil {
+		return x.Sort
+	}
+	return ListRequest_Oldest
+}
+
 type ListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	Status   string     `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Comments   []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Status     string     `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	NextCursor string     `protobuf:"bytes,3,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	Total      int64      `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
 }
 
 func (x *ListReply) Reset() {
@@ -654,133 +955,666 @@ func (x *ListReply) GetStatus() string {
 	return ""
 }
 
-var File_comments_proto protoreflect.FileDescriptor
+func (x *ListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
 
-var file_comments_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x02, 0x70, 0x62, 0x22, 0xaa, 0x01, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x22, 0x68, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x58, 0x0a, 0x0a, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
-	0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46,
-	0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x79, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x59, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x25, 0x0a, 0x0b, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
-	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
-	0x49, 0x44, 0x22, 0x70, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x27, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
-	0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61,
-	0x69, 0x6c, 0x10, 0x01, 0x32, 0x8b, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x29, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x06,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ListReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-var (
-	file_comments_proto_rawDescOnce sync.Once
-	file_comments_proto_rawDescData = file_comments_proto_rawDesc
-)
+type ReactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_comments_proto_rawDescGZIP() []byte {
-	file_comments_proto_rawDescOnce.Do(func() {
-		file_comments_proto_rawDescData = protoimpl.X.CompressGZIP(file_comments_proto_rawDescData)
-	})
-	return file_comments_proto_rawDescData
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-var file_comments_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_comments_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_comments_proto_goTypes = []interface{}{
-	(StoreReply_ReplyType)(0),  // 0: pb.StoreReply.ReplyType
-	(UpdateReply_ReplyType)(0), // 1: pb.UpdateReply.ReplyType
-	(ListReply_ReplyType)(0),   // 2: pb.ListReply.ReplyType
-	(*Comment)(nil),            // 3: pb.comment
-	(*StoreRequest)(nil),       // 4: pb.StoreRequest
-	(*StoreReply)(nil),         // 5: pb.StoreReply
-	(*UpdateRequest)(nil),      // 6: pb.UpdateRequest
-	(*UpdateReply)(nil),        // 7: pb.UpdateReply
-	(*ListRequest)(nil),        // 8: pb.ListRequest
-	(*ListReply)(nil),          // 9: pb.ListReply
+func (x *ReactRequest) Reset() {
+	*x = ReactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_comments_proto_depIdxs = []int32{
-	3, // 0: pb.ListReply.comments:type_name -> pb.comment
-	4, // 1: pb.Comments.Store:input_type -> pb.StoreRequest
-	6, // 2: pb.Comments.Update:input_type -> pb.UpdateRequest
-	8, // 3: pb.Comments.List:input_type -> pb.ListRequest
-	5, // 4: pb.Comments.Store:output_type -> pb.StoreReply
-	7, // 5: pb.Comments.Update:output_type -> pb.UpdateReply
-	9, // 6: pb.Comments.List:output_type -> pb.ListReply
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+func (x *ReactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_comments_proto_init() }
-func file_comments_proto_init() {
-	if File_comments_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_comments_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*ReactRequest) ProtoMessage() {}
+
+func (x *ReactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_comments_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactRequest.ProtoReflect.Descriptor instead.
+func (*ReactRequest) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReactRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ReactReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Likes  int64  `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ReactReply) Reset() {
+	*x = ReactReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactReply) ProtoMessage() {}
+
+func (x *ReactReply) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactReply.ProtoReflect.Descriptor instead.
+func (*ReactReply) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReactReply) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *ReactReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UnreactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *UnreactRequest) Reset() {
+	*x = UnreactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreactRequest) ProtoMessage() {}
+
+func (x *UnreactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreactRequest.ProtoReflect.Descriptor instead.
+func (*UnreactRequest) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnreactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnreactRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type UnreactReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Likes  int64  `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UnreactReply) Reset() {
+	*x = UnreactReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreactReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreactReply) ProtoMessage() {}
+
+func (x *UnreactReply) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreactReply.ProtoReflect.Descriptor instead.
+func (*UnreactReply) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnreactReply) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *UnreactReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// ModerateRequest applies a moderator action to a comment: approve, hide
+// or delete. Hidden comments are left out of List until they are approved.
+type ModerateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *ModerateRequest) Reset() {
+	*x = ModerateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateRequest) ProtoMessage() {}
+
+func (x *ModerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateRequest.ProtoReflect.Descriptor instead.
+func (*ModerateRequest) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ModerateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type ModerateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ModerateReply) Reset() {
+	*x = ModerateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReply) ProtoMessage() {}
+
+func (x *ModerateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReply.ProtoReflect.Descriptor instead.
+func (*ModerateReply) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ModerateReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// ExportUser is called by the users service for the data export of a user.
+type ExportUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ExportUserRequest) Reset() {
+	*x = ExportUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserRequest) ProtoMessage() {}
+
+func (x *ExportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserRequest) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ExportUserReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is a JSON document of the comments and reactions of the user
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ExportUserReply) Reset() {
+	*x = ExportUserReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserReply) ProtoMessage() {}
+
+func (x *ExportUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserReply.ProtoReflect.Descriptor instead.
+func (*ExportUserReply) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportUserReply) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportUserReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_comments_proto protoreflect.FileDescriptor
+
+var file_comments_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x22, 0x8a, 0x02, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x22, 0x84, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22,
+	0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c,
+	0x10, 0x01, 0x22, 0x79, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59, 0x0a,
+	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0xae, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2c,
+	0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x2b, 0x0a, 0x08,
+	0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x6c, 0x64, 0x65,
+	0x73, 0x74, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x54, 0x6f, 0x70, 0x10, 0x02, 0x22, 0xa6, 0x01, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x22,
+	0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c,
+	0x10, 0x01, 0x22, 0x36, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x5e, 0x0a, 0x0a, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x38, 0x0a, 0x0e, 0x55, 0x6e,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x60, 0x0a, 0x0c, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x39, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x4b, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x2b,
+	0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x61, 0x0a, 0x0f, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x32, 0xd5,
+	0x02, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x05,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x07, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x0a,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_comments_proto_rawDescOnce sync.Once
+	file_comments_proto_rawDescData = file_comments_proto_rawDesc
+)
+
+func file_comments_proto_rawDescGZIP() []byte {
+	file_comments_proto_rawDescOnce.Do(func() {
+		file_comments_proto_rawDescData = protoimpl.X.CompressGZIP(file_comments_proto_rawDescData)
+	})
+	return file_comments_proto_rawDescData
+}
+
+var file_comments_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_comments_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_comments_proto_goTypes = []interface{}{
+	(StoreReply_ReplyType)(0),      // 0: pb.StoreReply.ReplyType
+	(UpdateReply_ReplyType)(0),     // 1: pb.UpdateReply.ReplyType
+	(ListRequest_SortType)(0),      // 2: pb.ListRequest.SortType
+	(ListReply_ReplyType)(0),       // 3: pb.ListReply.ReplyType
+	(ReactReply_ReplyType)(0),      // 4: pb.ReactReply.ReplyType
+	(UnreactReply_ReplyType)(0),    // 5: pb.UnreactReply.ReplyType
+	(ModerateReply_ReplyType)(0),   // 6: pb.ModerateReply.ReplyType
+	(ExportUserReply_ReplyType)(0), // 7: pb.ExportUserReply.ReplyType
+	(*Comment)(nil),                // 8: pb.comment
+	(*StoreRequest)(nil),           // 9: pb.StoreRequest
+	(*StoreReply)(nil),             // 10: pb.StoreReply
+	(*UpdateRequest)(nil),          // 11: pb.UpdateRequest
+	(*UpdateReply)(nil),            // 12: pb.UpdateReply
+	(*ListRequest)(nil),            // 13: pb.ListRequest
+	(*ListReply)(nil),              // 14: pb.ListReply
+	(*ReactRequest)(nil),           // 15: pb.ReactRequest
+	(*ReactReply)(nil),             // 16: pb.ReactReply
+	(*UnreactRequest)(nil),         // 17: pb.UnreactRequest
+	(*UnreactReply)(nil),           // 18: pb.UnreactReply
+	(*ModerateRequest)(nil),        // 19: pb.ModerateRequest
+	(*ModerateReply)(nil),          // 20: pb.ModerateReply
+	(*ExportUserRequest)(nil),      // 21: pb.ExportUserRequest
+	(*ExportUserReply)(nil),        // 22: pb.ExportUserReply
+}
+var file_comments_proto_depIdxs = []int32{
+	2,  // 0: pb.ListRequest.sort:type_name -> pb.ListRequest.SortType
+	8,  // 1: pb.ListReply.comments:type_name -> pb.comment
+	9,  // 2: pb.Comments.Store:input_type -> pb.StoreRequest
+	11, // 3: pb.Comments.Update:input_type -> pb.UpdateRequest
+	13, // 4: pb.Comments.List:input_type -> pb.ListRequest
+	15, // 5: pb.Comments.React:input_type -> pb.ReactRequest
+	17, // 6: pb.Comments.Unreact:input_type -> pb.UnreactRequest
+	19, // 7: pb.Comments.Moderate:input_type -> pb.ModerateRequest
+	21, // 8: pb.Comments.ExportUser:input_type -> pb.ExportUserRequest
+	10, // 9: pb.Comments.Store:output_type -> pb.StoreReply
+	12, // 10: pb.Comments.Update:output_type -> pb.UpdateReply
+	14, // 11: pb.Comments.List:output_type -> pb.ListReply
+	16, // 12: pb.Comments.React:output_type -> pb.ReactReply
+	18, // 13: pb.Comments.Unreact:output_type -> pb.UnreactReply
+	20, // 14: pb.Comments.Moderate:output_type -> pb.ModerateReply
+	22, // 15: pb.Comments.ExportUser:output_type -> pb.ExportUserReply
+	9,  // [9:16] is the sub-list for method output_type
+	2,  // [2:9] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_comments_proto_init() }
+func file_comments_proto_init() {
+	if File_comments_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_comments_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
@@ -847,6 +1681,102 @@ func file_comments_proto_init() {
 				return nil
 			}
 		}
+		file_comments_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreactReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_comments_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Comment_Name)(nil),
@@ -857,8 +1787,8 @@ func file_comments_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_comments_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   7,
+			NumEnums:      8,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -888,6 +1818,10 @@ type CommentsClient interface {
 	Store(ctx context.Context, in *StoreRequest, opts ...grpc.CallOption) (*StoreReply, error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateReply, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListReply, error)
+	React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactReply, error)
+	Unreact(ctx context.Context, in *UnreactRequest, opts ...grpc.CallOption) (*UnreactReply, error)
+	Moderate(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*ModerateReply, error)
+	ExportUser(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (*ExportUserReply, error)
 }
 
 type commentsClient struct {
@@ -925,11 +1859,51 @@ func (c *commentsClient) List(ctx context.Context, in *ListRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *commentsClient) React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactReply, error) {
+	out := new(ReactReply)
+	err := c.cc.Invoke(ctx, "/pb.Comments/React", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) Unreact(ctx context.Context, in *UnreactRequest, opts ...grpc.CallOption) (*UnreactReply, error) {
+	out := new(UnreactReply)
+	err := c.cc.Invoke(ctx, "/pb.Comments/Unreact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) Moderate(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*ModerateReply, error) {
+	out := new(ModerateReply)
+	err := c.cc.Invoke(ctx, "/pb.Comments/Moderate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) ExportUser(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (*ExportUserReply, error) {
+	out := new(ExportUserReply)
+	err := c.cc.Invoke(ctx, "/pb.Comments/ExportUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentsServer is the server API for Comments service.
 type CommentsServer interface {
 	Store(context.Context, *StoreRequest) (*StoreReply, error)
 	Update(context.Context, *UpdateRequest) (*UpdateReply, error)
 	List(context.Context, *ListRequest) (*ListReply, error)
+	React(context.Context, *ReactRequest) (*ReactReply, error)
+	Unreact(context.Context, *UnreactRequest) (*UnreactReply, error)
+	Moderate(context.Context, *ModerateRequest) (*ModerateReply, error)
+	ExportUser(context.Context, *ExportUserRequest) (*ExportUserReply, error)
 }
 
 // UnimplementedCommentsServer can be embedded to have forward compatible implementations.
@@ -945,6 +1919,18 @@ func (*UnimplementedCommentsServer) Update(context.Context, *UpdateRequest) (*Up
 func (*UnimplementedCommentsServer) List(context.Context, *ListRequest) (*ListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (*UnimplementedCommentsServer) React(context.Context, *ReactRequest) (*ReactReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method React not implemented")
+}
+func (*UnimplementedCommentsServer) Unreact(context.Context, *UnreactRequest) (*UnreactReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unreact not implemented")
+}
+func (*UnimplementedCommentsServer) Moderate(context.Context, *ModerateRequest) (*ModerateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Moderate not implemented")
+}
+func (*UnimplementedCommentsServer) ExportUser(context.Context, *ExportUserRequest) (*ExportUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUser not implemented")
+}
 
 func RegisterCommentsServer(s *grpc.Server, srv CommentsServer) {
 	s.RegisterService(&_Comments_serviceDesc, srv)
@@ -1004,6 +1990,78 @@ func _Comments_List_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Comments_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Comments/React",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).React(ctx, req.(*ReactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_Unreact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnreactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).Unreact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Comments/Unreact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).Unreact(ctx, req.(*UnreactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_Moderate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).Moderate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Comments/Moderate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).Moderate(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_ExportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).ExportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Comments/ExportUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).ExportUser(ctx, req.(*ExportUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Comments_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Comments",
 	HandlerType: (*CommentsServer)(nil),
@@ -1020,6 +2078,22 @@ var _Comments_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Comments_List_Handler,
 		},
+		{
+			MethodName: "React",
+			Handler:    _Comments_React_Handler,
+		},
+		{
+			MethodName: "Unreact",
+			Handler:    _Comments_Unreact_Handler,
+		},
+		{
+			MethodName: "Moderate",
+			Handler:    _Comments_Moderate_Handler,
+		},
+		{
+			MethodName: "ExportUser",
+			Handler:    _Comments_ExportUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comments.proto",
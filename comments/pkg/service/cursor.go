@@ -0,0 +1,92 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrCursor is returned by List for a cursor it did not issue.
+	ErrCursor = status.Error(codes.InvalidArgument, "invalid cursor")
+	// ErrSort is returned by List for an unsupported sort order.
+	ErrSort = status.Error(codes.InvalidArgument, "unknown sort order")
+)
+
+// cursor marks the last comment of a page, so the next page can
+// continue right after it. Comments are ordered by _id rather than
+// createdAt, the ObjectID holds the creation time and comments stored
+// before createdAt was added have it too.
+type cursor struct {
+	ID    string `json:"id"`
+	Score int64  `json:"score"`
+
+	oid primitive.ObjectID
+}
+
+func encodeCursor(cm *Comment) string {
+	if cm == nil {
+		return ""
+	}
+	bt, err := json.Marshal(cursor{ID: cm.ID, Score: cm.Score})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(bt)
+}
+
+func decodeCursor(s string) (*cursor, error) {
+	bt, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrCursor
+	}
+	c := &cursor{}
+	if err := json.Unmarshal(bt, c); err != nil {
+		return nil, ErrCursor
+	}
+	if c.oid, err = primitive.ObjectIDFromHex(c.ID); err != nil {
+		return nil, ErrCursor
+	}
+	return c, nil
+}
+
+// after returns the filter for the comments that come after c in the given order.
+func (c *cursor) after(sort string) []bson.M {
+	switch sort {
+	case SortNewest:
+		return []bson.M{{"_id": bson.M{"$lt": c.oid}}}
+	case SortTop:
+		return []bson.M{
+			{"score": bson.M{"$lt": c.Score}},
+			{"score": c.Score, "_id": bson.M{"$lt": c.oid}},
+		}
+	default:
+		return []bson.M{{"_id": bson.M{"$gt": c.oid}}}
+	}
+}
+
+// validSort reports whether sort is an order supported by List, an empty
+// sort lists the oldest comments first.
+func validSort(sort string) bool {
+	switch sort {
+	case "", SortOldest, SortNewest, SortTop:
+		return true
+	}
+	return false
+}
+
+// sortFor returns the mongo sort document for the given order.
+func sortFor(sort string) bson.D {
+	switch sort {
+	case SortNewest:
+		return bson.D{{Key: "_id", Value: -1}}
+	case SortTop:
+		return bson.D{{Key: "score", Value: -1}, {Key: "_id", Value: -1}}
+	default:
+		return bson.D{{Key: "_id", Value: 1}}
+	}
+}
@@ -37,9 +37,33 @@ func (l loggingMiddleware) Update(ctx context.Context, cm Comment) (id string, e
 	}()
 	return l.next.Update(ctx, cm)
 }
-func (l loggingMiddleware) List(ctx context.Context, postID string) (cms []*pb.Comment, err error) {
+func (l loggingMiddleware) List(ctx context.Context, q ListQuery) (cms []*pb.Comment, next string, total int64, err error) {
 	defer func() {
-		l.logger.Log("method", "List", "postID", postID, "cms", cms, "err", err)
+		l.logger.Log("method", "List", "q", q, "cms", cms, "next", next, "total", total, "err", err)
 	}()
-	return l.next.List(ctx, postID)
+	return l.next.List(ctx, q)
+}
+func (l loggingMiddleware) React(ctx context.Context, userID string, commentID string) (likes int64, err error) {
+	defer func() {
+		l.logger.Log("method", "React", "userID", userID, "commentID", commentID, "likes", likes, "err", err)
+	}()
+	return l.next.React(ctx, userID, commentID)
+}
+func (l loggingMiddleware) Unreact(ctx context.Context, userID string, commentID string) (likes int64, err error) {
+	defer func() {
+		l.logger.Log("method", "Unreact", "userID", userID, "commentID", commentID, "likes", likes, "err", err)
+	}()
+	return l.next.Unreact(ctx, userID, commentID)
+}
+func (l loggingMiddleware) Moderate(ctx context.Context, commentID string, action string) (err error) {
+	defer func() {
+		l.logger.Log("method", "Moderate", "commentID", commentID, "action", action, "err", err)
+	}()
+	return l.next.Moderate(ctx, commentID, action)
+}
+func (l loggingMiddleware) ExportUser(ctx context.Context, userID string) (data []byte, err error) {
+	defer func() {
+		l.logger.Log("method", "ExportUser", "userID", userID, "err", err)
+	}()
+	return l.next.ExportUser(ctx, userID)
 }
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/emadghaffari/kit-blog/comments/config"
+	"github.com/emadghaffari/kit-blog/comments/pkg/digest"
+	nt "github.com/emadghaffari/kit-blog/notificator/pkg/grpc/pb"
+	ps "github.com/emadghaffari/kit-blog/posts/pkg/grpc/pb"
+	"github.com/emadghaffari/kit-blog/users/pkg/auth"
+	us "github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
+)
+
+// notify tells the post author, and the parent author for a reply, about
+// the new comment. Events go through the digester so an author gets one
+// notification per window however busy the thread is.
+func (b *basicCommentsService) notify(cm Comment, parentUserID string) {
+	recipients := map[string]bool{}
+
+	post, err := b.posts.Get(context.Background(), &ps.GetRequest{Id: cm.PostID})
+	if err != nil {
+		log.Printf("Error in get post %s: %v", cm.PostID, err)
+	} else if post.GetPost().GetUserID() != "" {
+		recipients[post.GetPost().GetUserID()] = false
+	}
+	if parentUserID != "" {
+		recipients[parentUserID] = true
+	}
+
+	for id, reply := range recipients {
+		// nobody needs to hear about their own comment
+		if id == cm.UserID {
+			continue
+		}
+		b.digest.Add(digest.Event{
+			RecipientID: id,
+			CommenterID: cm.UserID,
+			PostID:      cm.PostID,
+			CommentID:   cm.ID,
+			Title:       post.GetPost().GetTitle(),
+			Body:        cm.Body,
+			Reply:       reply,
+		})
+	}
+}
+
+// sendDigest sends one notification for what a recipient collected in a window.
+func (b *basicCommentsService) sendDigest(recipientID string, count int, events []digest.Event) {
+	ctx := context.Background()
+
+	to, err := b.user.GetPrivate(auth.ServiceContext(ctx, config.Confs.Service.Key), &us.GetPrivateRequest{Id: recipientID})
+	if err != nil {
+		log.Printf("Error in get user %s: %v", recipientID, err)
+		return
+	}
+	req := &nt.SendRequest{
+		UserID: recipientID,
+		To:     to.Email,
+		Variables: map[string]string{
+			"username": to.Username,
+			"count":    strconv.Itoa(count),
+		},
+	}
+	if req.To == "" {
+		req.To = to.Phone
+	}
+
+	if count == 1 {
+		e := events[0]
+		req.TemplateID = "new-comment"
+		req.Variables["post"] = e.Title
+		req.Variables["comment"] = e.Body
+		if commenter, err := b.user.GetPublicProfile(ctx, &us.GetPublicProfileRequest{Id: e.CommenterID}); err == nil {
+			req.Variables["commenter"] = commenter.Username
+		}
+	} else {
+		req.TemplateID = "comment-digest"
+	}
+
+	if _, err := b.notificator.Send(auth.ServiceContext(ctx, config.Confs.Service.Key), req); err != nil {
+		log.Printf("failed to send notif: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"time"
 
@@ -12,19 +13,71 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/emadghaffari/kit-blog/comments/config"
+	"github.com/emadghaffari/kit-blog/comments/pkg/digest"
 	"github.com/emadghaffari/kit-blog/comments/pkg/grpc/pb"
+	nt "github.com/emadghaffari/kit-blog/notificator/pkg/grpc/pb"
+	ps "github.com/emadghaffari/kit-blog/posts/pkg/grpc/pb"
+	"github.com/emadghaffari/kit-blog/users/pkg/auth"
+	"github.com/emadghaffari/kit-blog/users/pkg/events"
 	us "github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
 )
 
 // Comment struct
 type Comment struct {
-	ID     string `json:"id,omitempty" bson:"id"`
-	UserID string `json:"user_id,omitempty" bson:"user_id"`
-	PostID string `json:"post_id,omitempty" bson:"post_id"`
-	Title  string `json:"title,omitempty" bson:"title"`
-	Body   string `json:"body,omitempty" bson:"body"`
+	ID        string    `json:"id,omitempty" bson:"_id,omitempty"`
+	UserID    string    `json:"user_id,omitempty" bson:"user_id"`
+	PostID    string    `json:"post_id,omitempty" bson:"post_id"`
+	ParentID  string    `json:"parent_id,omitempty" bson:"parent_id,omitempty"`
+	Title     string    `json:"title,omitempty" bson:"title"`
+	Body      string    `json:"body,omitempty" bson:"body"`
+	Likes     int64     `json:"likes,omitempty" bson:"likes"`
+	Score     int64     `json:"score,omitempty" bson:"score"`
+	State     string    `json:"state,omitempty" bson:"state,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty" bson:"createdAt"`
+}
+
+// Sort orders supported by List.
+const (
+	SortOldest = "oldest"
+	SortNewest = "newest"
+	SortTop    = "top"
+)
+
+// Moderation actions supported by Moderate.
+const (
+	ModerationApprove = "approve"
+	ModerationHide    = "hide"
+	ModerationDelete  = "delete"
+)
+
+// States of a comment set by moderators, a comment without a state is
+// published.
+const (
+	StateApproved = "approved"
+	StateHidden   = "hidden"
+)
+
+// ErrUnknownModeration is returned by Moderate for an unsupported action.
+var ErrUnknownModeration = status.Error(codes.InvalidArgument, "unknown moderation action")
+
+const (
+	defaultListLimit int64 = 20
+	maxListLimit     int64 = 100
+)
+
+// deletedUsername is shown as the author of comments whose user is gone.
+const deletedUsername = "deleted user"
+
+// ListQuery collects the paging and ordering options for List.
+type ListQuery struct {
+	PostID string
+	Limit  int64
+	Cursor string
+	Sort   string
 }
 
 // CommentsService describes the service.
@@ -32,23 +85,52 @@ type CommentsService interface {
 	// Add your methods here
 	Store(ctx context.Context, cm Comment) (id string, err error)
 	Update(ctx context.Context, cm Comment) (id string, err error)
-	List(ctx context.Context, postID string) (cms []*pb.Comment, err error)
+	List(ctx context.Context, q ListQuery) (cms []*pb.Comment, next string, total int64, err error)
+	React(ctx context.Context, userID string, commentID string) (likes int64, err error)
+	Unreact(ctx context.Context, userID string, commentID string) (likes int64, err error)
+	Moderate(ctx context.Context, commentID string, action string) (err error)
+	ExportUser(ctx context.Context, userID string) (data []byte, err error)
 }
 
 type basicCommentsService struct {
-	user us.UsersClient
-	db   *mongo.Collection
+	user        us.UsersClient
+	posts       ps.PostsClient
+	notificator nt.NotificatorClient
+	digest      *digest.Digester
+	db          *mongo.Collection
+	reactions   *mongo.Collection
 }
 
 func (b *basicCommentsService) Store(ctx context.Context, cm Comment) (id string, err error) {
 	tracer := opentracing.GlobalTracer()
 	span := tracer.StartSpan("store")
 
+	// the author is whoever signed the request
+	if id, ok := auth.FromContext(ctx); ok {
+		cm.UserID = id.UserID
+	}
+
+	// a reply must answer a comment of the same post
+	parent := Comment{}
+	if cm.ParentID != "" {
+		poid, err := primitive.ObjectIDFromHex(cm.ParentID)
+		if err != nil {
+			return "FAILD", err
+		}
+		if err := b.db.FindOne(context.Background(), bson.M{"_id": poid, "post_id": cm.PostID}).Decode(&parent); err != nil {
+			return "FAILD", err
+		}
+	}
+
 	values := bson.M{
-		"post_id": cm.PostID,
-		"user_id": cm.UserID,
-		"title":   cm.Title,
-		"body":    cm.Body,
+		"post_id":   cm.PostID,
+		"parent_id": cm.ParentID,
+		"user_id":   cm.UserID,
+		"title":     cm.Title,
+		"body":      cm.Body,
+		"likes":     0,
+		"score":     0,
+		"createdAt": time.Now().UTC(),
 	}
 	res, err := b.db.InsertOne(context.Background(), values)
 
@@ -63,6 +145,9 @@ func (b *basicCommentsService) Store(ctx context.Context, cm Comment) (id string
 		return "FAILD", err
 	}
 
+	cm.ID = oid.Hex()
+	go b.notify(cm, parent.UserID)
+
 	span.Finish()
 	return oid.Hex(), nil
 }
@@ -79,10 +164,11 @@ func (b *basicCommentsService) Update(ctx context.Context, cm Comment) (id strin
 	if err := res.Decode(&data); err != nil {
 		return "FAILD", err
 	}
-	data.Body = cm.Body
-	data.Title = cm.Title
+	if err := auth.Allow(ctx, data.UserID, auth.RoleModerator); err != nil {
+		return "FAILD", err
+	}
 
-	_, err = b.db.ReplaceOne(context.Background(), filter, data)
+	_, err = b.db.UpdateOne(context.Background(), filter, bson.M{"$set": bson.M{"title": cm.Title, "body": cm.Body}})
 	if err != nil {
 		return "FAILD", nil
 	}
@@ -90,36 +176,245 @@ func (b *basicCommentsService) Update(ctx context.Context, cm Comment) (id strin
 	return oid.Hex(), err
 }
 
-func (b *basicCommentsService) List(ctx context.Context, postID string) (cms []*pb.Comment, err error) {
+func (b *basicCommentsService) List(ctx context.Context, q ListQuery) (cms []*pb.Comment, next string, total int64, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("list")
+	defer span.Finish()
+
+	if !validSort(q.Sort) {
+		return []*pb.Comment{}, "", 0, ErrSort
+	}
+	if q.Limit <= 0 {
+		q.Limit = defaultListLimit
+	}
+	if q.Limit > maxListLimit {
+		q.Limit = maxListLimit
+	}
 
 	items := []*pb.Comment{}
-	cur, err := b.db.Find(context.Background(), bson.M{"post_id": postID})
+	filter := bson.M{"post_id": q.PostID, "state": bson.M{"$ne": StateHidden}}
+	total, err = b.db.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return items, "", 0, err
+	}
+
+	if q.Cursor != "" {
+		c, err := decodeCursor(q.Cursor)
+		if err != nil {
+			return items, "", total, err
+		}
+		filter["$or"] = c.after(q.Sort)
+	}
+
+	// fetch one extra document to know whether there is a next page
+	opts := options.Find().SetSort(sortFor(q.Sort)).SetLimit(q.Limit + 1)
+	cur, err := b.db.Find(context.Background(), filter, opts)
 	if err != nil {
-		return items, nil
+		return items, "", total, err
 	}
 	defer cur.Close(context.Background())
 
+	var last *Comment
 	for cur.Next(context.Background()) {
-		data := &Comment{}
-		err := cur.Decode(data)
-		if err != nil {
-			return items, nil
+		if int64(len(items)) == q.Limit {
+			next = encodeCursor(last)
+			break
 		}
-		res, err := b.user.Get(context.Background(), &us.GetRequest{Id: data.UserID})
-		if err != nil {
-			return items, nil
+		data := &Comment{}
+		if err := cur.Decode(data); err != nil {
+			return items, "", total, err
 		}
+		username := b.username(data.UserID)
 		items = append(items,
-			&pb.Comment{PostID: data.PostID,
+			&pb.Comment{
+				Id:        data.ID,
+				PostID:    data.PostID,
+				ParentID:  data.ParentID,
 				Title:     data.Title,
 				Body:      data.Body,
-				Username:  &pb.Comment_Name{Name: res.Username},
-				Useremail: &pb.Comment_Email{Email: res.Email},
+				CreatedAt: data.CreatedAt.Format(time.RFC3339),
+				Likes:     data.Likes,
+				Username:  &pb.Comment_Name{Name: username},
 			},
 		)
+		last = data
+	}
+
+	return items, next, total, cur.Err()
+}
+
+// username returns the name to show for the author of a comment. Comments
+// of deleted users are anonymized and have no author, and a user can be
+// gone before their comments are, so neither fails the page.
+func (b *basicCommentsService) username(userID string) string {
+	if userID == "" {
+		return deletedUsername
+	}
+	res, err := b.user.GetPublicProfile(context.Background(), &us.GetPublicProfileRequest{Id: userID})
+	if err != nil {
+		log.Printf("Error in get user %s: %v", userID, err)
+		return deletedUsername
+	}
+	return res.Username
+}
+
+// React records a like from userID on the comment. A user can like a
+// comment only once, so repeated calls leave the counter as it is.
+func (b *basicCommentsService) React(ctx context.Context, userID string, commentID string) (likes int64, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("react")
+	defer span.Finish()
+
+	if id, ok := auth.FromContext(ctx); ok {
+		userID = id.UserID
+	}
+
+	oid, err := primitive.ObjectIDFromHex(commentID)
+	if err != nil {
+		return 0, err
+	}
+
+	reaction := bson.M{"user_id": userID, "target_id": commentID}
+	_, err = b.reactions.InsertOne(context.Background(), bson.M{
+		"user_id":   userID,
+		"target_id": commentID,
+		"createdAt": time.Now().UTC(),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return b.likes(oid)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	likes, err = b.incLikes(oid, 1)
+	if err != nil {
+		// the comment is gone, do not keep a dangling reaction
+		b.reactions.DeleteOne(context.Background(), reaction)
+		return 0, err
+	}
+	return likes, nil
+}
+
+// Unreact removes the like of userID from the comment, if there is one.
+func (b *basicCommentsService) Unreact(ctx context.Context, userID string, commentID string) (likes int64, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("unreact")
+	defer span.Finish()
+
+	if id, ok := auth.FromContext(ctx); ok {
+		userID = id.UserID
+	}
+
+	oid, err := primitive.ObjectIDFromHex(commentID)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := b.reactions.DeleteOne(context.Background(), bson.M{"user_id": userID, "target_id": commentID})
+	if err != nil {
+		return 0, err
+	}
+	if res.DeletedCount == 0 {
+		return b.likes(oid)
+	}
+
+	return b.incLikes(oid, -1)
+}
+
+// incLikes moves the likes counter and the score of a comment by n.
+func (b *basicCommentsService) incLikes(oid primitive.ObjectID, n int64) (int64, error) {
+	data := Comment{}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := b.db.FindOneAndUpdate(context.Background(), bson.M{"_id": oid}, bson.M{"$inc": bson.M{"likes": n, "score": n}}, opts)
+	if err := res.Decode(&data); err != nil {
+		return 0, err
+	}
+	return data.Likes, nil
+}
+
+func (b *basicCommentsService) likes(oid primitive.ObjectID) (int64, error) {
+	data := Comment{}
+	if err := b.db.FindOne(context.Background(), bson.M{"_id": oid}).Decode(&data); err != nil {
+		return 0, err
+	}
+	return data.Likes, nil
+}
+
+// Moderate approves, hides or deletes a comment. Hidden comments stay in
+// the database but are left out of List until a moderator approves them,
+// deleting a comment also drops its likes.
+func (b *basicCommentsService) Moderate(ctx context.Context, commentID string, action string) (err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("moderate")
+	defer span.Finish()
+
+	oid, err := primitive.ObjectIDFromHex(commentID)
+	if err != nil {
+		return err
 	}
+	filter := bson.M{"_id": oid}
+
+	switch action {
+	case ModerationApprove, ModerationHide:
+		state := StateApproved
+		if action == ModerationHide {
+			state = StateHidden
+		}
+		res, err := b.db.UpdateOne(context.Background(), filter, bson.M{"$set": bson.M{"state": state}})
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			return mongo.ErrNoDocuments
+		}
+		return nil
+	case ModerationDelete:
+		res, err := b.db.DeleteOne(context.Background(), filter)
+		if err != nil {
+			return err
+		}
+		if res.DeletedCount == 0 {
+			return mongo.ErrNoDocuments
+		}
+		_, err = b.reactions.DeleteMany(context.Background(), bson.M{"target_id": commentID})
+		return err
+	default:
+		return ErrUnknownModeration
+	}
+}
 
-	return items, err
+// ExportUser returns the comments and likes of the user as JSON, for the data
+// export of the users service.
+func (b *basicCommentsService) ExportUser(ctx context.Context, userID string) (data []byte, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("export_user")
+	defer span.Finish()
+
+	if err := auth.Allow(ctx, userID, auth.RoleAdmin, auth.RoleService); err != nil {
+		return nil, err
+	}
+
+	// every stored field, an export holds all we know
+	export := struct {
+		Comments  []bson.M `json:"comments"`
+		Reactions []bson.M `json:"reactions"`
+	}{}
+	cur, err := b.db.Find(context.Background(), bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	if err := cur.All(context.Background(), &export.Comments); err != nil {
+		return nil, err
+	}
+	cur, err = b.reactions.Find(context.Background(), bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	if err := cur.All(context.Background(), &export.Reactions); err != nil {
+		return nil, err
+	}
+	return json.Marshal(export)
 }
 
 // NewBasicCommentsService returns a naive, stateless implementation of CommentsService.
@@ -129,15 +424,113 @@ func NewBasicCommentsService() CommentsService {
 		return new(basicCommentsService)
 	}
 
+	postsConn, err := dial(config.Confs.Posts.Host)
+	if err != nil {
+		return new(basicCommentsService)
+	}
+
+	notifsConn, err := dial(config.Confs.Notifs.Host)
+	if err != nil {
+		return new(basicCommentsService)
+	}
+
 	col, err := initMongoDB()
 	if err != nil {
 		return new(basicCommentsService)
 	}
 
-	return &basicCommentsService{
-		user: us.NewUsersClient(conn),
-		db:   col,
+	b := &basicCommentsService{
+		user:        us.NewUsersClient(conn),
+		posts:       ps.NewPostsClient(postsConn),
+		notificator: nt.NewNotificatorClient(notifsConn),
+		db:          col,
+		reactions:   col.Database().Collection("reactions"),
 	}
+	b.digest = digest.New(config.Confs.Digest.Window, b.sendDigest)
+	go events.NewOutbox(col.Database().Client()).Consume(context.Background(), "comments", time.Minute, b.userDeleted, events.UserDeleted)
+	return b
+}
+
+// userDeleted drops the likes of a deleted user and deletes or anonymizes
+// their comments, as configured. A deleted comment that has replies keeps
+// its place in the thread with its content removed.
+func (b *basicCommentsService) userDeleted(ctx context.Context, e events.Event) error {
+	if err := b.dropReactions(ctx, e.UserID); err != nil {
+		return err
+	}
+
+	if config.Confs.DeletedUsers.Policy != events.PolicyDelete {
+		_, err := b.db.UpdateMany(ctx, bson.M{"user_id": e.UserID}, bson.M{"$set": bson.M{"user_id": ""}})
+		return err
+	}
+
+	cur, err := b.db.Find(ctx, bson.M{"user_id": e.UserID}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	comments := []Comment{}
+	if err := cur.All(ctx, &comments); err != nil {
+		return err
+	}
+	ids := make([]string, len(comments))
+	for i, c := range comments {
+		ids[i] = c.ID
+	}
+	replied, err := b.db.Distinct(ctx, "parent_id", bson.M{"parent_id": bson.M{"$in": ids}})
+	if err != nil {
+		return err
+	}
+	kept := bson.A{}
+	for _, id := range replied {
+		s, _ := id.(string)
+		if oid, err := primitive.ObjectIDFromHex(s); err == nil {
+			kept = append(kept, oid)
+		}
+	}
+
+	_, err = b.db.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": kept}}, bson.M{"$set": bson.M{"user_id": "", "title": "", "body": "", "likes": 0, "score": 0}})
+	if err != nil {
+		return err
+	}
+	if _, err := b.reactions.DeleteMany(ctx, bson.M{"target_id": bson.M{"$in": ids}}); err != nil {
+		return err
+	}
+	_, err = b.db.DeleteMany(ctx, bson.M{"user_id": e.UserID, "_id": bson.M{"$nin": kept}})
+	return err
+}
+
+// dropReactions removes the likes of userID and takes them off the
+// counters of the comments.
+func (b *basicCommentsService) dropReactions(ctx context.Context, userID string) error {
+	cur, err := b.reactions.Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		r := struct {
+			ID       primitive.ObjectID `bson:"_id"`
+			TargetID string             `bson:"target_id"`
+		}{}
+		if err := cur.Decode(&r); err != nil {
+			return err
+		}
+		// only the call that removed the reaction moves the counter
+		res, err := b.reactions.DeleteOne(ctx, bson.M{"_id": r.ID})
+		if err != nil {
+			return err
+		}
+		if res.DeletedCount == 0 {
+			continue
+		}
+		if oid, err := primitive.ObjectIDFromHex(r.TargetID); err == nil {
+			if _, err := b.incLikes(oid, -1); err != nil && err != mongo.ErrNoDocuments {
+				return err
+			}
+		}
+	}
+	return cur.Err()
 }
 
 // New returns a CommentsService with all of the expected middleware wired in.
@@ -163,9 +556,37 @@ func initMongoDB() (*mongo.Collection, error) {
 		return nil, err
 	}
 
-	users := client.Database("kit-comments").Collection("comments")
+	comments := client.Database("kit-comments").Collection("comments")
 
-	return users, nil
+	// List filters by post and pages by _id, or by score for the top sort
+	_, err = comments.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "post_id", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "post_id", Value: 1}, {Key: "score", Value: 1}, {Key: "_id", Value: 1}}},
+	})
+	if err != nil {
+		log.Printf(err.Error())
+		return nil, err
+	}
+
+	// comments stored before likes were counted have no score, which the
+	// top sort cursor would skip
+	_, err = comments.UpdateMany(ctx, bson.M{"score": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"score": 0, "likes": 0}})
+	if err != nil {
+		log.Printf(err.Error())
+		return nil, err
+	}
+
+	// one reaction per user per comment
+	_, err = comments.Database().Collection("reactions").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "target_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		log.Printf(err.Error())
+		return nil, err
+	}
+
+	return comments, nil
 
 }
 
@@ -181,3 +602,15 @@ func initUsers() (*grpc.ClientConn, error) {
 	}
 	return conn, nil
 }
+
+func dial(host string) (*grpc.ClientConn, error) {
+	tracer := opentracing.GlobalTracer()
+	conn, err := grpc.Dial(host,
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(otgrpc.OpenTracingClientInterceptor(tracer, otgrpc.LogPayloads())))
+	if err != nil {
+		log.Printf("unable to connect to %s, %s", host, err.Error())
+		return nil, err
+	}
+	return conn, nil
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io"
@@ -9,10 +10,12 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	endpoint1 "github.com/go-kit/kit/endpoint"
 	log "github.com/go-kit/kit/log"
 	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	grpc2 "github.com/go-kit/kit/transport/grpc"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/hashicorp/vault/api"
 	group "github.com/oklog/oklog/pkg/group"
@@ -31,6 +34,10 @@ import (
 	grpc "github.com/emadghaffari/kit-blog/notificator/pkg/grpc"
 	pb "github.com/emadghaffari/kit-blog/notificator/pkg/grpc/pb"
 	service "github.com/emadghaffari/kit-blog/notificator/pkg/service"
+	"github.com/emadghaffari/kit-blog/users/pkg/auth"
+	us "github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
+	"github.com/emadghaffari/kit-blog/users/pkg/keys"
+	"github.com/emadghaffari/kit-blog/users/pkg/tokens"
 )
 
 var tracer opentracinggo.Tracer
@@ -49,6 +56,10 @@ var thriftFramed = fs.Bool("thrift-framed", false, "true to enable framing")
 var zipkinURL = fs.String("zipkin-url", "", "Enable Zipkin tracing via a collector URL e.g. http://localhost:9411/api/v1/spans")
 var lightstepToken = fs.String("lightstep-token", "", "Enable LightStep tracing via a LightStep access token")
 var appdashAddr = fs.String("appdash-addr", "", "Enable Appdash tracing via an Appdash server host:port")
+var queueWorkers = fs.Int("queue-workers", 4, "number of notification delivery workers")
+var queueAttempts = fs.Int("queue-attempts", 5, "delivery attempts before a notification is dead-lettered")
+var brokerKind = fs.String("broker", "memory", "memory for a single instance, redis to fan out subscriptions across instances")
+var defaultLocale = fs.String("default-locale", "en", "locale used when a template has no version for the requested one")
 
 // Run func
 func Run() {
@@ -110,6 +121,9 @@ func Run() {
 func initGRPCHandler(endpoints endpoint.Endpoints, g *group.Group) {
 	options := defaultGRPCOptions(logger, tracer)
 	// Add your GRPC options here
+	for m := range options {
+		options[m] = append(options[m], grpc2.ServerBefore(auth.GRPCToContext()))
+	}
 
 	grpcServer := grpc.NewGRPCServer(endpoints, options)
 	grpcListener, err := net.Listen("tcp", *grpcAddr)
@@ -148,9 +162,48 @@ func getEndpointMiddleware(logger log.Logger) (mw map[string][]endpoint1.Middlew
 	}, []string{"method", "success"})
 	addDefaultEndpointMiddleware(logger, duration, mw)
 	// Add you endpoint middleware here
+	authn := initAuth()
+	service := auth.WithService(authn, config.Confs.Service.Key)
+	// Send delivers to any address, so it is open to the blog services only
+	mw["Send"] = append(mw["Send"], auth.Middleware(service, auth.AnyRole(auth.RoleService)))
+	// templates are managed by admins and read by admins and the blog services
+	for _, m := range []string{"CreateTemplate", "UpdateTemplate", "DeleteTemplate"} {
+		mw[m] = append(mw[m], auth.Middleware(authn, auth.All(auth.Interactive(), auth.AnyRole(auth.RoleAdmin))))
+	}
+	for _, m := range []string{"GetTemplate", "ListTemplates"} {
+		mw[m] = append(mw[m], auth.Middleware(service, auth.AnyRole(auth.RoleService)))
+	}
+	for _, m := range []string{"GetPreferences", "UpdatePreferences", "ListForRecipient", "MarkRead", "MarkAllRead", "UnreadCount"} {
+		mw[m] = append(mw[m], auth.Middleware(authn, auth.Authenticated()))
+	}
+	// called by the users service for the data export of a user
+	mw["ExportUser"] = append(mw["ExportUser"], auth.Middleware(auth.WithService(authn, config.Confs.Service.Key), auth.AnyRole(auth.RoleService)))
 
 	return
 }
+
+// initAuth returns an Authenticator checking access tokens with the JWKS of
+// the users service and asking it about API keys, or about every token when
+// users did not publish a JWKS.
+func initAuth() auth.Authenticator {
+	conn, err := grpc1.Dial(config.Confs.Users.Host, grpc1.WithInsecure())
+	if err != nil {
+		logger.Log("during", "Dial", "users", "err", err)
+		return auth.AuthenticatorFunc(func(context.Context, string) (auth.Identity, error) {
+			return auth.Identity{}, auth.ErrUnauthenticated
+		})
+	}
+	authn := auth.NewClient(us.NewUsersClient(conn))
+	if config.Confs.Users.JWKS == "" {
+		return authn
+	}
+	return auth.WithLocal(auth.NewLocal(&tokens.Parser{
+		Keys:     keys.NewRemote(config.Confs.Users.JWKS),
+		Issuer:   config.Confs.Users.Issuer,
+		Audience: config.Confs.Users.Audience,
+		Skew:     30 * time.Second,
+	}), authn)
+}
 func initMetricsEndpoint(g *group.Group) {
 	http.DefaultServeMux.Handle("/metrics", promhttp.Handler())
 	debugListener, err := net.Listen("tcp", *debugAddr)
@@ -189,6 +242,15 @@ func initVault() error {
 	config.Confs.Notifs.GrpcAddr = *grpcAddr
 	config.Confs.Notifs.ThriftAddr = *thriftAddr
 	config.Confs.Notifs.Host = "localhost"
+	config.Confs.SMTP.Path = "blog/smtp"
+	config.Confs.SMS.Path = "blog/sms"
+	config.Confs.Queue.Workers = *queueWorkers
+	config.Confs.Queue.MaxAttempts = *queueAttempts
+	config.Confs.Templates.DefaultLocale = *defaultLocale
+	config.Confs.Broker.Kind = *brokerKind
+	config.Confs.Redis.Path = "blog/redis"
+	config.Confs.Users.Path = "blog/users"
+	config.Confs.Service.Path = "blog/service"
 
 	confs := &api.Config{
 		Address: config.Confs.Vault.Address,
@@ -202,6 +264,69 @@ func initVault() error {
 	c := client.Logical()
 	config.Confs.Vault.Logical = c
 
+	// Read smtp path, email channel stays disabled without it
+	smtp, err := c.Read(config.Confs.SMTP.Path)
+	if err != nil {
+		logger.Log(err)
+		return err
+	}
+	if smtp != nil {
+		config.Confs.SMTP.Addr, _ = smtp.Data["addr"].(string)
+		config.Confs.SMTP.From, _ = smtp.Data["from"].(string)
+		config.Confs.SMTP.Username, _ = smtp.Data["username"].(string)
+		config.Confs.SMTP.Password, _ = smtp.Data["password"].(string)
+	}
+
+	// Read sms path, sms channel stays disabled without it
+	sms, err := c.Read(config.Confs.SMS.Path)
+	if err != nil {
+		logger.Log(err)
+		return err
+	}
+	if sms != nil {
+		config.Confs.SMS.URL, _ = sms.Data["url"].(string)
+		config.Confs.SMS.APIKey, _ = sms.Data["apikey"].(string)
+		config.Confs.SMS.From, _ = sms.Data["from"].(string)
+	}
+
+	// Read users path, callers are authenticated by the users service
+	users, err := c.Read(config.Confs.Users.Path)
+	if err != nil {
+		logger.Log(err)
+		return err
+	}
+	if users != nil {
+		config.Confs.Users.Host, _ = users.Data["grpc"].(string)
+		config.Confs.Users.JWKS, _ = users.Data["jwks"].(string)
+		config.Confs.Users.Issuer, _ = users.Data["issuer"].(string)
+		config.Confs.Users.Audience, _ = users.Data["audience"].(string)
+	}
+
+	// Read the service key, only the blog services may send notifications
+	svc, err := c.Read(config.Confs.Service.Path)
+	if err != nil {
+		logger.Log(err)
+		return err
+	}
+	if svc == nil {
+		return fmt.Errorf("no service key at %s", config.Confs.Service.Path)
+	}
+	config.Confs.Service.Key, _ = svc.Data["key"].(string)
+
+	// Read redis path, only needed by the redis broker
+	if config.Confs.Broker.Kind == "redis" {
+		rd, err := c.Read(config.Confs.Redis.Path)
+		if err != nil {
+			logger.Log(err)
+			return err
+		}
+		if rd == nil {
+			return fmt.Errorf("redis broker needs %s", config.Confs.Redis.Path)
+		}
+		config.Confs.Redis.Host, _ = rd.Data["host"].(string)
+		config.Confs.Redis.DB, _ = rd.Data["db"].(string)
+	}
+
 	// Write Notifs Path
 	_, err = c.Write(config.Confs.Notifs.Path, map[string]interface{}{
 		"debug":  config.Confs.Notifs.Host + config.Confs.Notifs.DebugAddr,
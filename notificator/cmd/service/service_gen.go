@@ -19,17 +19,47 @@ func createService(endpoints endpoint.Endpoints) (g *group.Group) {
 	return g
 }
 func defaultGRPCOptions(logger log.Logger, tracer opentracinggo.Tracer) map[string][]grpc.ServerOption {
-	options := map[string][]grpc.ServerOption{"Send": {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Send", logger))}}
+	options := map[string][]grpc.ServerOption{
+		"CreateTemplate":    {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "CreateTemplate", logger))},
+		"DeleteTemplate":    {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "DeleteTemplate", logger))},
+		"ExportUser":        {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "ExportUser", logger))},
+		"GetPreferences":    {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "GetPreferences", logger))},
+		"GetStatus":         {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "GetStatus", logger))},
+		"GetTemplate":       {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "GetTemplate", logger))},
+		"ListForRecipient":  {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "ListForRecipient", logger))},
+		"ListTemplates":     {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "ListTemplates", logger))},
+		"MarkAllRead":       {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "MarkAllRead", logger))},
+		"MarkRead":          {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "MarkRead", logger))},
+		"Send":              {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Send", logger))},
+		"Subscribe":         {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Subscribe", logger))},
+		"UnreadCount":       {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "UnreadCount", logger))},
+		"UpdatePreferences": {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "UpdatePreferences", logger))},
+		"UpdateTemplate":    {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "UpdateTemplate", logger))},
+	}
 	return options
 }
 func addDefaultEndpointMiddleware(logger log.Logger, duration *prometheus.Summary, mw map[string][]endpoint1.Middleware) {
 	mw["Send"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Send")), endpoint.InstrumentingMiddleware(duration.With("method", "Send"))}
+	mw["GetStatus"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "GetStatus")), endpoint.InstrumentingMiddleware(duration.With("method", "GetStatus"))}
+	mw["CreateTemplate"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "CreateTemplate")), endpoint.InstrumentingMiddleware(duration.With("method", "CreateTemplate"))}
+	mw["GetTemplate"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "GetTemplate")), endpoint.InstrumentingMiddleware(duration.With("method", "GetTemplate"))}
+	mw["UpdateTemplate"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "UpdateTemplate")), endpoint.InstrumentingMiddleware(duration.With("method", "UpdateTemplate"))}
+	mw["DeleteTemplate"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "DeleteTemplate")), endpoint.InstrumentingMiddleware(duration.With("method", "DeleteTemplate"))}
+	mw["ListTemplates"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "ListTemplates")), endpoint.InstrumentingMiddleware(duration.With("method", "ListTemplates"))}
+	mw["GetPreferences"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "GetPreferences")), endpoint.InstrumentingMiddleware(duration.With("method", "GetPreferences"))}
+	mw["UpdatePreferences"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "UpdatePreferences")), endpoint.InstrumentingMiddleware(duration.With("method", "UpdatePreferences"))}
+	mw["ListForRecipient"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "ListForRecipient")), endpoint.InstrumentingMiddleware(duration.With("method", "ListForRecipient"))}
+	mw["MarkRead"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "MarkRead")), endpoint.InstrumentingMiddleware(duration.With("method", "MarkRead"))}
+	mw["MarkAllRead"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "MarkAllRead")), endpoint.InstrumentingMiddleware(duration.With("method", "MarkAllRead"))}
+	mw["UnreadCount"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "UnreadCount")), endpoint.InstrumentingMiddleware(duration.With("method", "UnreadCount"))}
+	mw["Subscribe"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Subscribe")), endpoint.InstrumentingMiddleware(duration.With("method", "Subscribe"))}
+	mw["ExportUser"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "ExportUser")), endpoint.InstrumentingMiddleware(duration.With("method", "ExportUser"))}
 }
 func addDefaultServiceMiddleware(logger log.Logger, mw []service.Middleware) []service.Middleware {
 	return append(mw, service.LoggingMiddleware(logger))
 }
 func addEndpointMiddlewareToAllMethods(mw map[string][]endpoint1.Middleware, m endpoint1.Middleware) {
-	methods := []string{"Send"}
+	methods := []string{"Send", "GetStatus", "CreateTemplate", "GetTemplate", "UpdateTemplate", "DeleteTemplate", "ListTemplates", "GetPreferences", "UpdatePreferences", "ListForRecipient", "MarkRead", "MarkAllRead", "UnreadCount", "Subscribe", "ExportUser"}
 	for _, v := range methods {
 		mw[v] = append(mw[v], m)
 	}
@@ -16,6 +16,49 @@ type (
 			GrpcAddr   string
 			ThriftAddr string
 		}
+		SMTP struct {
+			Path     string
+			Addr     string
+			From     string
+			Username string
+			Password string
+		}
+		SMS struct {
+			Path   string
+			URL    string
+			APIKey string
+			From   string
+		}
+		Queue struct {
+			Workers     int
+			MaxAttempts int
+		}
+		Templates struct {
+			DefaultLocale string
+		}
+		Broker struct {
+			Kind string
+		}
+		Redis struct {
+			Path string
+			Host string
+			DB   string
+		}
+		Users struct {
+			Host string
+			Path string
+			// JWKS, Issuer and Audience check access tokens without a call
+			// to users, which is asked about API keys only
+			JWKS     string
+			Issuer   string
+			Audience string
+		}
+		Service struct {
+			Path string
+			// Key is shared by the blog services to call the methods
+			// users may not, like Send
+			Key string
+		}
 		Vault struct {
 			Address string
 			Token   string
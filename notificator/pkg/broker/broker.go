@@ -0,0 +1,19 @@
+package broker
+
+import (
+	"context"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+)
+
+// Broker fans new notifications out to the subscribers of their recipient.
+type Broker interface {
+	// Publish hands n to the current subscribers of n.UserID. It never
+	// blocks on slow subscribers.
+	Publish(ctx context.Context, n model.Notification) error
+	// Subscribe returns the notifications published for userID until ctx is
+	// done. The channel is closed when ctx is done, or earlier when the
+	// subscriber falls too far behind; the client should then resync from
+	// the inbox and subscribe again.
+	Subscribe(ctx context.Context, userID string) (<-chan model.Notification, error)
+}
@@ -0,0 +1,79 @@
+package broker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+)
+
+// buffer is how many notifications a subscriber may lag behind before it
+// is disconnected.
+const buffer = 64
+
+type subscriber struct {
+	ch   chan model.Notification
+	once sync.Once
+}
+
+func (s *subscriber) close() {
+	s.once.Do(func() { close(s.ch) })
+}
+
+// Memory is a Broker for a single instance.
+type Memory struct {
+	mu   sync.RWMutex
+	subs map[string]map[*subscriber]struct{}
+}
+
+// NewMemory returns an in-process Broker.
+func NewMemory() *Memory {
+	return &Memory{subs: map[string]map[*subscriber]struct{}{}}
+}
+
+// Publish implements Broker.
+func (m *Memory) Publish(ctx context.Context, n model.Notification) error {
+	m.mu.RLock()
+	slow := []*subscriber{}
+	for s := range m.subs[n.UserID] {
+		select {
+		case s.ch <- n:
+		default:
+			slow = append(slow, s)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, s := range slow {
+		m.remove(n.UserID, s)
+	}
+	return nil
+}
+
+// Subscribe implements Broker.
+func (m *Memory) Subscribe(ctx context.Context, userID string) (<-chan model.Notification, error) {
+	s := &subscriber{ch: make(chan model.Notification, buffer)}
+
+	m.mu.Lock()
+	if m.subs[userID] == nil {
+		m.subs[userID] = map[*subscriber]struct{}{}
+	}
+	m.subs[userID][s] = struct{}{}
+	m.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		m.remove(userID, s)
+	}()
+	return s.ch, nil
+}
+
+func (m *Memory) remove(userID string, s *subscriber) {
+	m.mu.Lock()
+	delete(m.subs[userID], s)
+	if len(m.subs[userID]) == 0 {
+		delete(m.subs, userID)
+	}
+	m.mu.Unlock()
+	s.close()
+}
@@ -0,0 +1,59 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	rd "github.com/go-redis/redis"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+)
+
+const prefix = "notifications:"
+
+// Redis is a Broker for several instances. Notifications are published on
+// a redis channel per recipient and every instance delivers them to its own
+// subscribers through a Memory broker.
+type Redis struct {
+	db    *rd.Client
+	local *Memory
+}
+
+// NewRedis returns a Broker on db. It listens to redis until ctx is done.
+func NewRedis(ctx context.Context, db *rd.Client) *Redis {
+	r := &Redis{db: db, local: NewMemory()}
+	go r.listen(ctx)
+	return r
+}
+
+// Publish implements Broker.
+func (r *Redis) Publish(ctx context.Context, n model.Notification) error {
+	bt, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	return r.db.Publish(ctx, prefix+n.UserID, bt).Err()
+}
+
+// Subscribe implements Broker.
+func (r *Redis) Subscribe(ctx context.Context, userID string) (<-chan model.Notification, error) {
+	return r.local.Subscribe(ctx, userID)
+}
+
+func (r *Redis) listen(ctx context.Context) {
+	ps := r.db.PSubscribe(ctx, prefix+"*")
+	go func() {
+		<-ctx.Done()
+		ps.Close()
+	}()
+
+	for msg := range ps.Channel() {
+		n := model.Notification{}
+		if err := json.Unmarshal([]byte(msg.Payload), &n); err != nil {
+			log.Printf("Error in decode notification from %s: %v", msg.Channel, err)
+			continue
+		}
+		r.local.Publish(ctx, n)
+	}
+}
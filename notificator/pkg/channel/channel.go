@@ -0,0 +1,50 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// Channel names
+const (
+	Email = "email"
+	SMS   = "sms"
+)
+
+var (
+	// ErrUnknownRecipient is returned when a recipient is neither an email nor a phone number
+	ErrUnknownRecipient = errors.New("recipient is neither an email address nor a phone number")
+
+	phone = regexp.MustCompile(`^\+?[0-9][0-9 ()-]{5,18}[0-9]$`)
+)
+
+// Message is a single notification for one recipient.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Channel delivers messages to recipients over one medium.
+type Channel interface {
+	Name() string
+	Deliver(ctx context.Context, msg Message) error
+}
+
+// Route picks the channel for a recipient by the shape of the address.
+func Route(to string) (string, error) {
+	to = strings.TrimSpace(to)
+	if strings.Contains(to, "@") {
+		if _, err := mail.ParseAddress(to); err == nil {
+			return Email, nil
+		}
+		return "", ErrUnknownRecipient
+	}
+	if phone.MatchString(to) {
+		return SMS, nil
+	}
+	return "", ErrUnknownRecipient
+}
@@ -0,0 +1,63 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type email struct {
+	addr     string
+	host     string
+	from     string
+	username string
+	password string
+}
+
+// NewEmail returns a Channel that delivers messages through an SMTP server
+// at addr ("host:port"). Authentication is skipped when username is empty,
+// which is what local SMTP sinks expect.
+func NewEmail(addr, from, username, password string) Channel {
+	host, _, _ := net.SplitHostPort(addr)
+	return &email{addr: addr, host: host, from: from, username: username, password: password}
+}
+
+func (e *email) Name() string {
+	return Email
+}
+
+func (e *email) Deliver(ctx context.Context, msg Message) error {
+	var auth smtp.Auth
+	if e.username != "" {
+		auth = smtp.PlainAuth("", e.username, e.password, e.host)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(e.addr, auth, e.from, []string{msg.To}, e.compose(msg))
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// compose builds a plain text RFC 5322 message.
+func (e *email) compose(msg Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", e.from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.Body)
+	return []byte(b.String())
+}
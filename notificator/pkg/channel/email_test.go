@@ -0,0 +1,120 @@
+package channel
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+// smtpSink accepts a single message over plain SMTP and records the
+// envelope and the data.
+type smtpSink struct {
+	from, to string
+	data     string
+	done     chan struct{}
+}
+
+func newSMTPSink(t *testing.T) (*smtpSink, string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpSink{done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(textproto.NewConn(conn))
+	}()
+	return s, l.Addr().String()
+}
+
+func (s *smtpSink) serve(c *textproto.Conn) {
+	c.PrintfLine("220 sink ready")
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			c.PrintfLine("250 sink")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			c.PrintfLine("250 ok")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			s.to = strings.Trim(line[len("RCPT TO:"):], "<> ")
+			c.PrintfLine("250 ok")
+		case cmd == "DATA":
+			c.PrintfLine("354 go ahead")
+			lines, err := c.ReadDotLines()
+			if err != nil {
+				return
+			}
+			s.data = strings.Join(lines, "\n")
+			c.PrintfLine("250 queued")
+		case cmd == "QUIT":
+			c.PrintfLine("221 bye")
+			return
+		default:
+			c.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestEmailDeliver(t *testing.T) {
+	sink, addr := newSMTPSink(t)
+
+	ch := NewEmail(addr, "blog@example.com", "", "")
+	err := ch.Deliver(context.Background(), Message{
+		To:      "user@example.com",
+		Subject: "Welcome",
+		Body:    "hello",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-sink.done
+
+	if sink.from != "blog@example.com" {
+		t.Errorf("MAIL FROM = %q", sink.from)
+	}
+	if sink.to != "user@example.com" {
+		t.Errorf("RCPT TO = %q", sink.to)
+	}
+	for _, want := range []string{
+		"From: blog@example.com",
+		"To: user@example.com",
+		"Subject: Welcome",
+		"Content-Type: text/plain; charset=\"utf-8\"",
+		"\n\nhello",
+	} {
+		if !strings.Contains(sink.data, want) {
+			t.Errorf("data is missing %q:\n%s", want, sink.data)
+		}
+	}
+}
+
+func TestEmailDeliverCanceled(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// The listener never greets, so Deliver can only return on cancel.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = NewEmail(l.Addr().String(), "blog@example.com", "", "").Deliver(ctx, Message{To: "user@example.com"})
+	if err != context.Canceled {
+		t.Fatalf("err = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,82 @@
+package channel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Provider sends text messages through an SMS gateway.
+type Provider interface {
+	SendSMS(ctx context.Context, to, body string) error
+}
+
+type sms struct {
+	provider Provider
+}
+
+// NewSMS returns a Channel that delivers messages through the given provider.
+func NewSMS(provider Provider) Channel {
+	return &sms{provider: provider}
+}
+
+func (s *sms) Name() string {
+	return SMS
+}
+
+func (s *sms) Deliver(ctx context.Context, msg Message) error {
+	return s.provider.SendSMS(ctx, msg.To, msg.Body)
+}
+
+type httpProvider struct {
+	url    string
+	apiKey string
+	from   string
+	client *http.Client
+}
+
+// NewHTTPProvider returns a Provider that posts every message as JSON to url,
+// authenticated with apiKey as a Bearer token.
+func NewHTTPProvider(url, apiKey, from string) Provider {
+	return &httpProvider{
+		url:    url,
+		apiKey: apiKey,
+		from:   from,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *httpProvider) SendSMS(ctx context.Context, to, body string) error {
+	bt, err := json.Marshal(map[string]string{
+		"from": p.from,
+		"to":   to,
+		"text": body,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.url, bytes.NewReader(bt))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("sms provider returned %s", res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPProviderSendSMS(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if h := r.Header.Get("Authorization"); h != "Bearer key" {
+			t.Errorf("Authorization = %q, want %q", h, "Bearer key")
+		}
+		if h := r.Header.Get("Content-Type"); h != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", h)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	p := NewHTTPProvider(srv.URL, "key", "blog")
+	if err := p.SendSMS(context.Background(), "+15550100", "hello"); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"from": "blog", "to": "+15550100", "text": "hello"}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestHTTPProviderNoKey(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h := r.Header.Get("Authorization"); h != "" {
+			t.Errorf("Authorization = %q, want none", h)
+		}
+	}))
+	defer srv.Close()
+
+	if err := NewHTTPProvider(srv.URL, "", "blog").SendSMS(context.Background(), "+15550100", "hello"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHTTPProviderStatus(t *testing.T) {
+	for _, code := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusInternalServerError} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(code)
+		}))
+		err := NewHTTPProvider(srv.URL, "key", "blog").SendSMS(context.Background(), "+15550100", "hello")
+		srv.Close()
+		if err == nil {
+			t.Errorf("status %d: got no error", code)
+		}
+	}
+}
@@ -2,14 +2,16 @@ package endpoint
 
 import (
 	"context"
-	service "github.com/emadghaffari/kit-blog/notificator/pkg/service"
+
 	endpoint "github.com/go-kit/kit/endpoint"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+	service "github.com/emadghaffari/kit-blog/notificator/pkg/service"
 )
 
 // SendRequest collects the request parameters for the Send method.
 type SendRequest struct {
-	To   string `json:"to"`
-	Body string `json:"body"`
+	Notification model.Notification `json:"notification"`
 }
 
 // SendResponse collects the response parameters for the Send method.
@@ -22,7 +24,7 @@ type SendResponse struct {
 func MakeSendEndpoint(s service.NotificatorService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(SendRequest)
-		id, err := s.Send(ctx, req.To, req.Body)
+		id, err := s.Send(ctx, req.Notification)
 		return SendResponse{
 			Err: err,
 			Id:  id,
@@ -35,6 +37,406 @@ func (r SendResponse) Failed() error {
 	return r.Err
 }
 
+// GetStatusRequest collects the request parameters for the GetStatus method.
+type GetStatusRequest struct {
+	Id string `json:"id"`
+}
+
+// GetStatusResponse collects the response parameters for the GetStatus method.
+type GetStatusResponse struct {
+	Notification model.Notification `json:"notification"`
+	Err          error              `json:"err"`
+}
+
+// MakeGetStatusEndpoint returns an endpoint that invokes GetStatus on the service.
+func MakeGetStatusEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetStatusRequest)
+		notification, err := s.GetStatus(ctx, req.Id)
+		return GetStatusResponse{
+			Notification: notification,
+			Err:          err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r GetStatusResponse) Failed() error {
+	return r.Err
+}
+
+// CreateTemplateRequest collects the request parameters for the CreateTemplate method.
+type CreateTemplateRequest struct {
+	T model.Template `json:"t"`
+}
+
+// CreateTemplateResponse collects the response parameters for the CreateTemplate method.
+type CreateTemplateResponse struct {
+	Id  string `json:"id"`
+	Err error  `json:"err"`
+}
+
+// MakeCreateTemplateEndpoint returns an endpoint that invokes CreateTemplate on the service.
+func MakeCreateTemplateEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateTemplateRequest)
+		id, err := s.CreateTemplate(ctx, req.T)
+		return CreateTemplateResponse{
+			Id:  id,
+			Err: err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r CreateTemplateResponse) Failed() error {
+	return r.Err
+}
+
+// GetTemplateRequest collects the request parameters for the GetTemplate method.
+type GetTemplateRequest struct {
+	Name   string `json:"name"`
+	Locale string `json:"locale"`
+}
+
+// GetTemplateResponse collects the response parameters for the GetTemplate method.
+type GetTemplateResponse struct {
+	T   model.Template `json:"t"`
+	Err error          `json:"err"`
+}
+
+// MakeGetTemplateEndpoint returns an endpoint that invokes GetTemplate on the service.
+func MakeGetTemplateEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetTemplateRequest)
+		t, err := s.GetTemplate(ctx, req.Name, req.Locale)
+		return GetTemplateResponse{
+			T:   t,
+			Err: err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r GetTemplateResponse) Failed() error {
+	return r.Err
+}
+
+// UpdateTemplateRequest collects the request parameters for the UpdateTemplate method.
+type UpdateTemplateRequest struct {
+	T model.Template `json:"t"`
+}
+
+// UpdateTemplateResponse collects the response parameters for the UpdateTemplate method.
+type UpdateTemplateResponse struct {
+	Id  string `json:"id"`
+	Err error  `json:"err"`
+}
+
+// MakeUpdateTemplateEndpoint returns an endpoint that invokes UpdateTemplate on the service.
+func MakeUpdateTemplateEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateTemplateRequest)
+		id, err := s.UpdateTemplate(ctx, req.T)
+		return UpdateTemplateResponse{
+			Id:  id,
+			Err: err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r UpdateTemplateResponse) Failed() error {
+	return r.Err
+}
+
+// DeleteTemplateRequest collects the request parameters for the DeleteTemplate method.
+type DeleteTemplateRequest struct {
+	Name   string `json:"name"`
+	Locale string `json:"locale"`
+}
+
+// DeleteTemplateResponse collects the response parameters for the DeleteTemplate method.
+type DeleteTemplateResponse struct {
+	Id  string `json:"id"`
+	Err error  `json:"err"`
+}
+
+// MakeDeleteTemplateEndpoint returns an endpoint that invokes DeleteTemplate on the service.
+func MakeDeleteTemplateEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteTemplateRequest)
+		id, err := s.DeleteTemplate(ctx, req.Name, req.Locale)
+		return DeleteTemplateResponse{
+			Id:  id,
+			Err: err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r DeleteTemplateResponse) Failed() error {
+	return r.Err
+}
+
+// ListTemplatesRequest collects the request parameters for the ListTemplates method.
+type ListTemplatesRequest struct {
+	Name string `json:"name"`
+}
+
+// ListTemplatesResponse collects the response parameters for the ListTemplates method.
+type ListTemplatesResponse struct {
+	Items []model.Template `json:"items"`
+	Err   error            `json:"err"`
+}
+
+// MakeListTemplatesEndpoint returns an endpoint that invokes ListTemplates on the service.
+func MakeListTemplatesEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListTemplatesRequest)
+		items, err := s.ListTemplates(ctx, req.Name)
+		return ListTemplatesResponse{
+			Items: items,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ListTemplatesResponse) Failed() error {
+	return r.Err
+}
+
+// GetPreferencesRequest collects the request parameters for the GetPreferences method.
+type GetPreferencesRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// GetPreferencesResponse collects the response parameters for the GetPreferences method.
+type GetPreferencesResponse struct {
+	P   model.Preferences `json:"p"`
+	Err error             `json:"err"`
+}
+
+// MakeGetPreferencesEndpoint returns an endpoint that invokes GetPreferences on the service.
+func MakeGetPreferencesEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetPreferencesRequest)
+		p, err := s.GetPreferences(ctx, req.UserID)
+		return GetPreferencesResponse{
+			P:   p,
+			Err: err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r GetPreferencesResponse) Failed() error {
+	return r.Err
+}
+
+// UpdatePreferencesRequest collects the request parameters for the UpdatePreferences method.
+type UpdatePreferencesRequest struct {
+	P model.Preferences `json:"p"`
+}
+
+// UpdatePreferencesResponse collects the response parameters for the UpdatePreferences method.
+type UpdatePreferencesResponse struct {
+	Saved model.Preferences `json:"saved"`
+	Err   error             `json:"err"`
+}
+
+// MakeUpdatePreferencesEndpoint returns an endpoint that invokes UpdatePreferences on the service.
+func MakeUpdatePreferencesEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdatePreferencesRequest)
+		saved, err := s.UpdatePreferences(ctx, req.P)
+		return UpdatePreferencesResponse{
+			Saved: saved,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r UpdatePreferencesResponse) Failed() error {
+	return r.Err
+}
+
+// ListForRecipientRequest collects the request parameters for the ListForRecipient method.
+type ListForRecipientRequest struct {
+	UserID     string `json:"user_id"`
+	Limit      int64  `json:"limit"`
+	Cursor     string `json:"cursor"`
+	UnreadOnly bool   `json:"unread_only"`
+}
+
+// ListForRecipientResponse collects the response parameters for the ListForRecipient method.
+type ListForRecipientResponse struct {
+	Items []model.Notification `json:"items"`
+	Next  string               `json:"next"`
+	Err   error                `json:"err"`
+}
+
+// MakeListForRecipientEndpoint returns an endpoint that invokes ListForRecipient on the service.
+func MakeListForRecipientEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListForRecipientRequest)
+		items, next, err := s.ListForRecipient(ctx, req.UserID, req.Limit, req.Cursor, req.UnreadOnly)
+		return ListForRecipientResponse{
+			Items: items,
+			Next:  next,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ListForRecipientResponse) Failed() error {
+	return r.Err
+}
+
+// MarkReadRequest collects the request parameters for the MarkRead method.
+type MarkReadRequest struct {
+	UserID string `json:"user_id"`
+	Id     string `json:"id"`
+}
+
+// MarkReadResponse collects the response parameters for the MarkRead method.
+type MarkReadResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeMarkReadEndpoint returns an endpoint that invokes MarkRead on the service.
+func MakeMarkReadEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(MarkReadRequest)
+		response, err := s.MarkRead(ctx, req.UserID, req.Id)
+		return MarkReadResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r MarkReadResponse) Failed() error {
+	return r.Err
+}
+
+// MarkAllReadRequest collects the request parameters for the MarkAllRead method.
+type MarkAllReadRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// MarkAllReadResponse collects the response parameters for the MarkAllRead method.
+type MarkAllReadResponse struct {
+	Count int64 `json:"count"`
+	Err   error `json:"err"`
+}
+
+// MakeMarkAllReadEndpoint returns an endpoint that invokes MarkAllRead on the service.
+func MakeMarkAllReadEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(MarkAllReadRequest)
+		count, err := s.MarkAllRead(ctx, req.UserID)
+		return MarkAllReadResponse{
+			Count: count,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r MarkAllReadResponse) Failed() error {
+	return r.Err
+}
+
+// UnreadCountRequest collects the request parameters for the UnreadCount method.
+type UnreadCountRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// UnreadCountResponse collects the response parameters for the UnreadCount method.
+type UnreadCountResponse struct {
+	Count int64 `json:"count"`
+	Err   error `json:"err"`
+}
+
+// MakeUnreadCountEndpoint returns an endpoint that invokes UnreadCount on the service.
+func MakeUnreadCountEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UnreadCountRequest)
+		count, err := s.UnreadCount(ctx, req.UserID)
+		return UnreadCountResponse{
+			Count: count,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r UnreadCountResponse) Failed() error {
+	return r.Err
+}
+
+// SubscribeRequest collects the request parameters for the Subscribe method.
+type SubscribeRequest struct {
+	Token string `json:"token"`
+}
+
+// SubscribeResponse collects the response parameters for the Subscribe method.
+type SubscribeResponse struct {
+	Events <-chan model.Notification `json:"events"`
+	Err    error                     `json:"err"`
+}
+
+// MakeSubscribeEndpoint returns an endpoint that invokes Subscribe on the service.
+func MakeSubscribeEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(SubscribeRequest)
+		events, err := s.Subscribe(ctx, req.Token)
+		return SubscribeResponse{
+			Events: events,
+			Err:    err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r SubscribeResponse) Failed() error {
+	return r.Err
+}
+
+// ExportUserRequest collects the request parameters for the ExportUser method.
+type ExportUserRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// ExportUserResponse collects the response parameters for the ExportUser method.
+type ExportUserResponse struct {
+	Data []byte `json:"data"`
+	Err  error  `json:"err"`
+}
+
+// MakeExportUserEndpoint returns an endpoint that invokes ExportUser on the service.
+func MakeExportUserEndpoint(s service.NotificatorService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ExportUserRequest)
+		data, err := s.ExportUser(ctx, req.UserID)
+		return ExportUserResponse{
+			Data: data,
+			Err:  err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ExportUserResponse) Failed() error {
+	return r.Err
+}
+
 // Failure is an interface that should be implemented by response types.
 // Response encoders can check if responses are Failer, and if so they've
 // failed, and if so encode them using a separate write path based on the error.
@@ -43,14 +445,151 @@ type Failure interface {
 }
 
 // Send implements Service. Primarily useful in a client.
-func (e Endpoints) Send(ctx context.Context, to string, body string) (id string, err error) {
-	request := SendRequest{
-		Body: body,
-		To:   to,
-	}
+func (e Endpoints) Send(ctx context.Context, n model.Notification) (id string, err error) {
+	request := SendRequest{Notification: n}
 	response, err := e.SendEndpoint(ctx, request)
 	if err != nil {
 		return
 	}
 	return response.(SendResponse).Id, response.(SendResponse).Err
 }
+
+// GetStatus implements Service. Primarily useful in a client.
+func (e Endpoints) GetStatus(ctx context.Context, id string) (notification model.Notification, err error) {
+	request := GetStatusRequest{Id: id}
+	response, err := e.GetStatusEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(GetStatusResponse).Notification, response.(GetStatusResponse).Err
+}
+
+// CreateTemplate implements Service. Primarily useful in a client.
+func (e Endpoints) CreateTemplate(ctx context.Context, t model.Template) (id string, err error) {
+	request := CreateTemplateRequest{T: t}
+	response, err := e.CreateTemplateEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(CreateTemplateResponse).Id, response.(CreateTemplateResponse).Err
+}
+
+// GetTemplate implements Service. Primarily useful in a client.
+func (e Endpoints) GetTemplate(ctx context.Context, name string, locale string) (t model.Template, err error) {
+	request := GetTemplateRequest{Name: name, Locale: locale}
+	response, err := e.GetTemplateEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(GetTemplateResponse).T, response.(GetTemplateResponse).Err
+}
+
+// UpdateTemplate implements Service. Primarily useful in a client.
+func (e Endpoints) UpdateTemplate(ctx context.Context, t model.Template) (id string, err error) {
+	request := UpdateTemplateRequest{T: t}
+	response, err := e.UpdateTemplateEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(UpdateTemplateResponse).Id, response.(UpdateTemplateResponse).Err
+}
+
+// DeleteTemplate implements Service. Primarily useful in a client.
+func (e Endpoints) DeleteTemplate(ctx context.Context, name string, locale string) (id string, err error) {
+	request := DeleteTemplateRequest{Name: name, Locale: locale}
+	response, err := e.DeleteTemplateEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(DeleteTemplateResponse).Id, response.(DeleteTemplateResponse).Err
+}
+
+// ListTemplates implements Service. Primarily useful in a client.
+func (e Endpoints) ListTemplates(ctx context.Context, name string) (items []model.Template, err error) {
+	request := ListTemplatesRequest{Name: name}
+	response, err := e.ListTemplatesEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ListTemplatesResponse).Items, response.(ListTemplatesResponse).Err
+}
+
+// GetPreferences implements Service. Primarily useful in a client.
+func (e Endpoints) GetPreferences(ctx context.Context, userID string) (p model.Preferences, err error) {
+	request := GetPreferencesRequest{UserID: userID}
+	response, err := e.GetPreferencesEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(GetPreferencesResponse).P, response.(GetPreferencesResponse).Err
+}
+
+// UpdatePreferences implements Service. Primarily useful in a client.
+func (e Endpoints) UpdatePreferences(ctx context.Context, p model.Preferences) (saved model.Preferences, err error) {
+	request := UpdatePreferencesRequest{P: p}
+	response, err := e.UpdatePreferencesEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(UpdatePreferencesResponse).Saved, response.(UpdatePreferencesResponse).Err
+}
+
+// ListForRecipient implements Service. Primarily useful in a client.
+func (e Endpoints) ListForRecipient(ctx context.Context, userID string, limit int64, cursor string, unreadOnly bool) (items []model.Notification, next string, err error) {
+	request := ListForRecipientRequest{UserID: userID, Limit: limit, Cursor: cursor, UnreadOnly: unreadOnly}
+	response, err := e.ListForRecipientEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ListForRecipientResponse).Items, response.(ListForRecipientResponse).Next, response.(ListForRecipientResponse).Err
+}
+
+// MarkRead implements Service. Primarily useful in a client.
+func (e Endpoints) MarkRead(ctx context.Context, userID string, id string) (s0 string, e1 error) {
+	request := MarkReadRequest{UserID: userID, Id: id}
+	response, err := e.MarkReadEndpoint(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	return response.(MarkReadResponse).Response, response.(MarkReadResponse).Err
+}
+
+// MarkAllRead implements Service. Primarily useful in a client.
+func (e Endpoints) MarkAllRead(ctx context.Context, userID string) (count int64, err error) {
+	request := MarkAllReadRequest{UserID: userID}
+	response, err := e.MarkAllReadEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(MarkAllReadResponse).Count, response.(MarkAllReadResponse).Err
+}
+
+// UnreadCount implements Service. Primarily useful in a client.
+func (e Endpoints) UnreadCount(ctx context.Context, userID string) (count int64, err error) {
+	request := UnreadCountRequest{UserID: userID}
+	response, err := e.UnreadCountEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(UnreadCountResponse).Count, response.(UnreadCountResponse).Err
+}
+
+// Subscribe implements Service. Primarily useful in a client.
+func (e Endpoints) Subscribe(ctx context.Context, token string) (events <-chan model.Notification, err error) {
+	request := SubscribeRequest{Token: token}
+	response, err := e.SubscribeEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(SubscribeResponse).Events, response.(SubscribeResponse).Err
+}
+
+// ExportUser implements Service. Primarily useful in a client.
+func (e Endpoints) ExportUser(ctx context.Context, userID string) (data []byte, err error) {
+	request := ExportUserRequest{UserID: userID}
+	response, err := e.ExportUserEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ExportUserResponse).Data, response.(ExportUserResponse).Err
+}
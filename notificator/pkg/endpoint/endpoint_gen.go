@@ -10,15 +10,87 @@ import (
 // meant to be used as a helper struct, to collect all of the endpoints into a
 // single parameter.
 type Endpoints struct {
-	SendEndpoint endpoint.Endpoint
+	SendEndpoint              endpoint.Endpoint
+	GetStatusEndpoint         endpoint.Endpoint
+	CreateTemplateEndpoint    endpoint.Endpoint
+	GetTemplateEndpoint       endpoint.Endpoint
+	UpdateTemplateEndpoint    endpoint.Endpoint
+	DeleteTemplateEndpoint    endpoint.Endpoint
+	ListTemplatesEndpoint     endpoint.Endpoint
+	GetPreferencesEndpoint    endpoint.Endpoint
+	UpdatePreferencesEndpoint endpoint.Endpoint
+	ListForRecipientEndpoint  endpoint.Endpoint
+	MarkReadEndpoint          endpoint.Endpoint
+	MarkAllReadEndpoint       endpoint.Endpoint
+	UnreadCountEndpoint       endpoint.Endpoint
+	SubscribeEndpoint         endpoint.Endpoint
+	ExportUserEndpoint        endpoint.Endpoint
 }
 
 // New returns a Endpoints struct that wraps the provided service, and wires in all of the
 // expected endpoint middlewares
 func New(s service.NotificatorService, mdw map[string][]endpoint.Middleware) Endpoints {
-	eps := Endpoints{SendEndpoint: MakeSendEndpoint(s)}
+	eps := Endpoints{
+		CreateTemplateEndpoint:    MakeCreateTemplateEndpoint(s),
+		DeleteTemplateEndpoint:    MakeDeleteTemplateEndpoint(s),
+		ExportUserEndpoint:        MakeExportUserEndpoint(s),
+		GetPreferencesEndpoint:    MakeGetPreferencesEndpoint(s),
+		GetStatusEndpoint:         MakeGetStatusEndpoint(s),
+		GetTemplateEndpoint:       MakeGetTemplateEndpoint(s),
+		ListForRecipientEndpoint:  MakeListForRecipientEndpoint(s),
+		ListTemplatesEndpoint:     MakeListTemplatesEndpoint(s),
+		MarkAllReadEndpoint:       MakeMarkAllReadEndpoint(s),
+		MarkReadEndpoint:          MakeMarkReadEndpoint(s),
+		SendEndpoint:              MakeSendEndpoint(s),
+		SubscribeEndpoint:         MakeSubscribeEndpoint(s),
+		UnreadCountEndpoint:       MakeUnreadCountEndpoint(s),
+		UpdatePreferencesEndpoint: MakeUpdatePreferencesEndpoint(s),
+		UpdateTemplateEndpoint:    MakeUpdateTemplateEndpoint(s),
+	}
 	for _, m := range mdw["Send"] {
 		eps.SendEndpoint = m(eps.SendEndpoint)
 	}
+	for _, m := range mdw["GetStatus"] {
+		eps.GetStatusEndpoint = m(eps.GetStatusEndpoint)
+	}
+	for _, m := range mdw["CreateTemplate"] {
+		eps.CreateTemplateEndpoint = m(eps.CreateTemplateEndpoint)
+	}
+	for _, m := range mdw["GetTemplate"] {
+		eps.GetTemplateEndpoint = m(eps.GetTemplateEndpoint)
+	}
+	for _, m := range mdw["UpdateTemplate"] {
+		eps.UpdateTemplateEndpoint = m(eps.UpdateTemplateEndpoint)
+	}
+	for _, m := range mdw["DeleteTemplate"] {
+		eps.DeleteTemplateEndpoint = m(eps.DeleteTemplateEndpoint)
+	}
+	for _, m := range mdw["ListTemplates"] {
+		eps.ListTemplatesEndpoint = m(eps.ListTemplatesEndpoint)
+	}
+	for _, m := range mdw["GetPreferences"] {
+		eps.GetPreferencesEndpoint = m(eps.GetPreferencesEndpoint)
+	}
+	for _, m := range mdw["UpdatePreferences"] {
+		eps.UpdatePreferencesEndpoint = m(eps.UpdatePreferencesEndpoint)
+	}
+	for _, m := range mdw["ListForRecipient"] {
+		eps.ListForRecipientEndpoint = m(eps.ListForRecipientEndpoint)
+	}
+	for _, m := range mdw["MarkRead"] {
+		eps.MarkReadEndpoint = m(eps.MarkReadEndpoint)
+	}
+	for _, m := range mdw["MarkAllRead"] {
+		eps.MarkAllReadEndpoint = m(eps.MarkAllReadEndpoint)
+	}
+	for _, m := range mdw["UnreadCount"] {
+		eps.UnreadCountEndpoint = m(eps.UnreadCountEndpoint)
+	}
+	for _, m := range mdw["Subscribe"] {
+		eps.SubscribeEndpoint = m(eps.SubscribeEndpoint)
+	}
+	for _, m := range mdw["ExportUser"] {
+		eps.ExportUserEndpoint = m(eps.ExportUserEndpoint)
+	}
 	return eps
 }
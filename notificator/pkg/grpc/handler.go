@@ -2,12 +2,19 @@ package grpc
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	grpc "github.com/go-kit/kit/transport/grpc"
 	context1 "golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/emadghaffari/kit-blog/notificator/pkg/channel"
 	endpoint "github.com/emadghaffari/kit-blog/notificator/pkg/endpoint"
 	pb "github.com/emadghaffari/kit-blog/notificator/pkg/grpc/pb"
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
 )
 
 // makeSendHandler creates the handler logic
@@ -20,7 +27,18 @@ func makeSendHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption)
 func decodeSendRequest(_ context.Context, r interface{}) (interface{}, error) {
 	req := r.(*pb.SendRequest)
 
-	return endpoint.SendRequest{To: req.To, Body: req.Body}, nil
+	return endpoint.SendRequest{
+		Notification: model.Notification{
+			UserID:    req.UserID,
+			To:        req.To,
+			Subject:   req.Subject,
+			Body:      req.Body,
+			Channel:   channelName(req.Channel),
+			Template:  req.TemplateID,
+			Locale:    req.Locale,
+			Variables: req.Variables,
+		},
+	}, nil
 }
 
 // encodeSendResponse is a transport/grpc.EncodeResponseFunc that converts
@@ -39,3 +57,524 @@ func (g *grpcServer) Send(ctx context1.Context, req *pb.SendRequest) (*pb.SendRe
 	}
 	return rep.(*pb.SendReply), nil
 }
+
+// channelName maps the requested channel to a service channel; Auto leaves
+// it empty so the service routes by the recipient address.
+func channelName(c pb.SendRequest_ChannelType) string {
+	switch c {
+	case pb.SendRequest_Email:
+		return channel.Email
+	case pb.SendRequest_Sms:
+		return channel.SMS
+	default:
+		return ""
+	}
+}
+
+// makeGetStatusHandler creates the handler logic
+func makeGetStatusHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.GetStatusEndpoint, decodeGetStatusRequest, encodeGetStatusResponse, options...)
+}
+
+// decodeGetStatusResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain GetStatus request.
+func decodeGetStatusRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.GetStatusRequest)
+	return endpoint.GetStatusRequest{Id: req.Id}, nil
+}
+
+// encodeGetStatusResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeGetStatusResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.GetStatusResponse)
+	if resp.Err != nil {
+		return &pb.GetStatusReply{Id: "", Status: pb.GetStatusReply_Fail}, resp.Err
+	}
+	return &pb.GetStatusReply{
+		Id:        resp.Notification.ID,
+		State:     resp.Notification.Status,
+		Attempts:  int32(resp.Notification.Attempts),
+		LastError: resp.Notification.LastError,
+		Channel:   resp.Notification.Channel,
+		UpdatedAt: resp.Notification.UpdatedAt.Format(time.RFC3339),
+		Reason:    resp.Notification.Reason,
+		Status:    pb.GetStatusReply_Success,
+	}, nil
+}
+func (g *grpcServer) GetStatus(ctx context1.Context, req *pb.GetStatusRequest) (*pb.GetStatusReply, error) {
+	_, rep, err := g.getStatus.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetStatusReply), nil
+}
+
+// makeCreateTemplateHandler creates the handler logic
+func makeCreateTemplateHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.CreateTemplateEndpoint, decodeCreateTemplateRequest, encodeCreateTemplateResponse, options...)
+}
+
+// decodeCreateTemplateResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain CreateTemplate request.
+func decodeCreateTemplateRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.CreateTemplateRequest)
+	return endpoint.CreateTemplateRequest{T: fromPBTemplate(req.Template)}, nil
+}
+
+// encodeCreateTemplateResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeCreateTemplateResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.CreateTemplateResponse)
+	if resp.Err != nil {
+		return &pb.CreateTemplateReply{Status: pb.CreateTemplateReply_Fail}, resp.Err
+	}
+	return &pb.CreateTemplateReply{Id: resp.Id, Status: pb.CreateTemplateReply_Success}, nil
+}
+func (g *grpcServer) CreateTemplate(ctx context1.Context, req *pb.CreateTemplateRequest) (*pb.CreateTemplateReply, error) {
+	_, rep, err := g.createTemplate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.CreateTemplateReply), nil
+}
+
+// makeGetTemplateHandler creates the handler logic
+func makeGetTemplateHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.GetTemplateEndpoint, decodeGetTemplateRequest, encodeGetTemplateResponse, options...)
+}
+
+// decodeGetTemplateResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain GetTemplate request.
+func decodeGetTemplateRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.GetTemplateRequest)
+	return endpoint.GetTemplateRequest{Name: req.Name, Locale: req.Locale}, nil
+}
+
+// encodeGetTemplateResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeGetTemplateResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.GetTemplateResponse)
+	if resp.Err != nil {
+		return &pb.GetTemplateReply{Status: pb.GetTemplateReply_Fail}, resp.Err
+	}
+	return &pb.GetTemplateReply{Template: toPBTemplate(resp.T), Status: pb.GetTemplateReply_Success}, nil
+}
+func (g *grpcServer) GetTemplate(ctx context1.Context, req *pb.GetTemplateRequest) (*pb.GetTemplateReply, error) {
+	_, rep, err := g.getTemplate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetTemplateReply), nil
+}
+
+// makeUpdateTemplateHandler creates the handler logic
+func makeUpdateTemplateHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.UpdateTemplateEndpoint, decodeUpdateTemplateRequest, encodeUpdateTemplateResponse, options...)
+}
+
+// decodeUpdateTemplateResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain UpdateTemplate request.
+func decodeUpdateTemplateRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.UpdateTemplateRequest)
+	return endpoint.UpdateTemplateRequest{T: fromPBTemplate(req.Template)}, nil
+}
+
+// encodeUpdateTemplateResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeUpdateTemplateResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.UpdateTemplateResponse)
+	if resp.Err != nil {
+		return &pb.UpdateTemplateReply{Status: pb.UpdateTemplateReply_Fail}, resp.Err
+	}
+	return &pb.UpdateTemplateReply{Id: resp.Id, Status: pb.UpdateTemplateReply_Success}, nil
+}
+func (g *grpcServer) UpdateTemplate(ctx context1.Context, req *pb.UpdateTemplateRequest) (*pb.UpdateTemplateReply, error) {
+	_, rep, err := g.updateTemplate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UpdateTemplateReply), nil
+}
+
+// makeDeleteTemplateHandler creates the handler logic
+func makeDeleteTemplateHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.DeleteTemplateEndpoint, decodeDeleteTemplateRequest, encodeDeleteTemplateResponse, options...)
+}
+
+// decodeDeleteTemplateResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain DeleteTemplate request.
+func decodeDeleteTemplateRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.DeleteTemplateRequest)
+	return endpoint.DeleteTemplateRequest{Name: req.Name, Locale: req.Locale}, nil
+}
+
+// encodeDeleteTemplateResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeDeleteTemplateResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.DeleteTemplateResponse)
+	if resp.Err != nil {
+		return &pb.DeleteTemplateReply{Status: pb.DeleteTemplateReply_Fail}, resp.Err
+	}
+	return &pb.DeleteTemplateReply{Id: resp.Id, Status: pb.DeleteTemplateReply_Success}, nil
+}
+func (g *grpcServer) DeleteTemplate(ctx context1.Context, req *pb.DeleteTemplateRequest) (*pb.DeleteTemplateReply, error) {
+	_, rep, err := g.deleteTemplate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.DeleteTemplateReply), nil
+}
+
+// makeListTemplatesHandler creates the handler logic
+func makeListTemplatesHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ListTemplatesEndpoint, decodeListTemplatesRequest, encodeListTemplatesResponse, options...)
+}
+
+// decodeListTemplatesResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain ListTemplates request.
+func decodeListTemplatesRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ListTemplatesRequest)
+	return endpoint.ListTemplatesRequest{Name: req.Name}, nil
+}
+
+// encodeListTemplatesResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeListTemplatesResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ListTemplatesResponse)
+	if resp.Err != nil {
+		return &pb.ListTemplatesReply{Status: pb.ListTemplatesReply_Fail}, resp.Err
+	}
+	return &pb.ListTemplatesReply{Templates: toPBTemplates(resp.Items), Status: pb.ListTemplatesReply_Success}, nil
+}
+func (g *grpcServer) ListTemplates(ctx context1.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesReply, error) {
+	_, rep, err := g.listTemplates.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ListTemplatesReply), nil
+}
+
+// makeSubscribeHandler creates the handler logic
+func makeSubscribeHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.SubscribeEndpoint, decodeSubscribeRequest, encodeSubscribeResponse, options...)
+}
+
+// decodeSubscribeRequest is a transport/grpc.DecodeRequestFunc that takes the
+// access token from the "authorization" metadata of the stream.
+func decodeSubscribeRequest(ctx context.Context, r interface{}) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	token := ""
+	if v := md.Get("authorization"); len(v) > 0 {
+		token = strings.TrimPrefix(v[0], "Bearer ")
+	}
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+	return endpoint.SubscribeRequest{Token: token}, nil
+}
+
+// encodeSubscribeResponse passes the subscription through, Subscribe below
+// streams it.
+func encodeSubscribeResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.SubscribeResponse)
+	if resp.Err != nil {
+		return nil, status.Error(codes.Unauthenticated, resp.Err.Error())
+	}
+	return resp, nil
+}
+
+// Subscribe sends every notification of the subscription until the client
+// goes away, which cancels the stream context and ends the subscription.
+func (g *grpcServer) Subscribe(req *pb.SubscribeRequest, stream pb.Notificator_SubscribeServer) error {
+	_, rep, err := g.subscribe.ServeGRPC(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	for n := range rep.(endpoint.SubscribeResponse).Events {
+		if err := stream.Send(toPBInbox([]model.Notification{n})[0]); err != nil {
+			return err
+		}
+	}
+	if stream.Context().Err() != nil {
+		return nil
+	}
+	// the subscription was dropped because the client could not keep up
+	return status.Error(codes.ResourceExhausted, "subscriber too slow, resync from the inbox")
+}
+
+// fromPBTemplate converts a gRPC template, which may be missing, to the model.
+func fromPBTemplate(t *pb.Template) model.Template {
+	return model.Template{
+		ID:      t.GetId(),
+		Name:    t.GetName(),
+		Locale:  t.GetLocale(),
+		Subject: t.GetSubject(),
+		Body:    t.GetBody(),
+		HTML:    t.GetHtml(),
+	}
+}
+
+func toPBTemplate(t model.Template) *pb.Template {
+	return &pb.Template{
+		Id:      t.ID,
+		Name:    t.Name,
+		Locale:  t.Locale,
+		Subject: t.Subject,
+		Body:    t.Body,
+		Html:    t.HTML,
+	}
+}
+
+func toPBTemplates(items []model.Template) []*pb.Template {
+	out := make([]*pb.Template, 0, len(items))
+	for _, t := range items {
+		out = append(out, toPBTemplate(t))
+	}
+	return out
+}
+
+// makeGetPreferencesHandler creates the handler logic
+func makeGetPreferencesHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.GetPreferencesEndpoint, decodeGetPreferencesRequest, encodeGetPreferencesResponse, options...)
+}
+
+// decodeGetPreferencesResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain GetPreferences request.
+func decodeGetPreferencesRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.GetPreferencesRequest)
+	return endpoint.GetPreferencesRequest{UserID: req.UserID}, nil
+}
+
+// encodeGetPreferencesResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeGetPreferencesResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.GetPreferencesResponse)
+	if resp.Err != nil {
+		return &pb.GetPreferencesReply{Status: pb.GetPreferencesReply_Fail}, resp.Err
+	}
+	return &pb.GetPreferencesReply{Preferences: toPBPreferences(resp.P), Status: pb.GetPreferencesReply_Success}, nil
+}
+func (g *grpcServer) GetPreferences(ctx context1.Context, req *pb.GetPreferencesRequest) (*pb.GetPreferencesReply, error) {
+	_, rep, err := g.getPreferences.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetPreferencesReply), nil
+}
+
+// makeUpdatePreferencesHandler creates the handler logic
+func makeUpdatePreferencesHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.UpdatePreferencesEndpoint, decodeUpdatePreferencesRequest, encodeUpdatePreferencesResponse, options...)
+}
+
+// decodeUpdatePreferencesResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain UpdatePreferences request.
+func decodeUpdatePreferencesRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.UpdatePreferencesRequest)
+	return endpoint.UpdatePreferencesRequest{P: fromPBPreferences(req.Preferences)}, nil
+}
+
+// encodeUpdatePreferencesResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeUpdatePreferencesResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.UpdatePreferencesResponse)
+	if resp.Err != nil {
+		return &pb.UpdatePreferencesReply{Status: pb.UpdatePreferencesReply_Fail}, resp.Err
+	}
+	return &pb.UpdatePreferencesReply{Preferences: toPBPreferences(resp.Saved), Status: pb.UpdatePreferencesReply_Success}, nil
+}
+func (g *grpcServer) UpdatePreferences(ctx context1.Context, req *pb.UpdatePreferencesRequest) (*pb.UpdatePreferencesReply, error) {
+	_, rep, err := g.updatePreferences.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UpdatePreferencesReply), nil
+}
+
+// fromPBPreferences converts gRPC preferences, which may be missing, to the model.
+func fromPBPreferences(p *pb.Preferences) model.Preferences {
+	return model.Preferences{
+		UserID:   p.GetUserID(),
+		Events:   p.GetEvents(),
+		Channels: p.GetChannels(),
+		QuietHours: model.QuietHours{
+			Start:    p.GetQuietHours().GetStart(),
+			End:      p.GetQuietHours().GetEnd(),
+			Timezone: p.GetQuietHours().GetTimezone(),
+		},
+	}
+}
+
+func toPBPreferences(p model.Preferences) *pb.Preferences {
+	out := &pb.Preferences{
+		UserID:   p.UserID,
+		Events:   p.Events,
+		Channels: p.Channels,
+		QuietHours: &pb.QuietHours{
+			Start:    p.QuietHours.Start,
+			End:      p.QuietHours.End,
+			Timezone: p.QuietHours.Timezone,
+		},
+	}
+	if !p.UpdatedAt.IsZero() {
+		out.UpdatedAt = p.UpdatedAt.Format(time.RFC3339)
+	}
+	return out
+}
+
+// makeListForRecipientHandler creates the handler logic
+func makeListForRecipientHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ListForRecipientEndpoint, decodeListForRecipientRequest, encodeListForRecipientResponse, options...)
+}
+
+// decodeListForRecipientResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain ListForRecipient request.
+func decodeListForRecipientRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ListForRecipientRequest)
+	return endpoint.ListForRecipientRequest{UserID: req.UserID, Limit: req.Limit, Cursor: req.Cursor, UnreadOnly: req.UnreadOnly}, nil
+}
+
+// encodeListForRecipientResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeListForRecipientResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ListForRecipientResponse)
+	if resp.Err != nil {
+		return &pb.ListForRecipientReply{Status: pb.ListForRecipientReply_Fail}, resp.Err
+	}
+	return &pb.ListForRecipientReply{Items: toPBInbox(resp.Items), NextCursor: resp.Next, Status: pb.ListForRecipientReply_Success}, nil
+}
+func (g *grpcServer) ListForRecipient(ctx context1.Context, req *pb.ListForRecipientRequest) (*pb.ListForRecipientReply, error) {
+	_, rep, err := g.listForRecipient.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ListForRecipientReply), nil
+}
+
+// makeMarkReadHandler creates the handler logic
+func makeMarkReadHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.MarkReadEndpoint, decodeMarkReadRequest, encodeMarkReadResponse, options...)
+}
+
+// decodeMarkReadResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain MarkRead request.
+func decodeMarkReadRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.MarkReadRequest)
+	return endpoint.MarkReadRequest{UserID: req.UserID, Id: req.Id}, nil
+}
+
+// encodeMarkReadResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeMarkReadResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.MarkReadResponse)
+	if resp.Err != nil {
+		return &pb.MarkReadReply{Status: pb.MarkReadReply_Fail}, resp.Err
+	}
+	return &pb.MarkReadReply{Id: resp.Response, Status: pb.MarkReadReply_Success}, nil
+}
+func (g *grpcServer) MarkRead(ctx context1.Context, req *pb.MarkReadRequest) (*pb.MarkReadReply, error) {
+	_, rep, err := g.markRead.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.MarkReadReply), nil
+}
+
+// makeMarkAllReadHandler creates the handler logic
+func makeMarkAllReadHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.MarkAllReadEndpoint, decodeMarkAllReadRequest, encodeMarkAllReadResponse, options...)
+}
+
+// decodeMarkAllReadResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain MarkAllRead request.
+func decodeMarkAllReadRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.MarkAllReadRequest)
+	return endpoint.MarkAllReadRequest{UserID: req.UserID}, nil
+}
+
+// encodeMarkAllReadResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeMarkAllReadResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.MarkAllReadResponse)
+	if resp.Err != nil {
+		return &pb.MarkAllReadReply{Status: pb.MarkAllReadReply_Fail}, resp.Err
+	}
+	return &pb.MarkAllReadReply{Count: resp.Count, Status: pb.MarkAllReadReply_Success}, nil
+}
+func (g *grpcServer) MarkAllRead(ctx context1.Context, req *pb.MarkAllReadRequest) (*pb.MarkAllReadReply, error) {
+	_, rep, err := g.markAllRead.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.MarkAllReadReply), nil
+}
+
+// makeUnreadCountHandler creates the handler logic
+func makeUnreadCountHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.UnreadCountEndpoint, decodeUnreadCountRequest, encodeUnreadCountResponse, options...)
+}
+
+// decodeUnreadCountResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain UnreadCount request.
+func decodeUnreadCountRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.UnreadCountRequest)
+	return endpoint.UnreadCountRequest{UserID: req.UserID}, nil
+}
+
+// encodeUnreadCountResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeUnreadCountResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.UnreadCountResponse)
+	if resp.Err != nil {
+		return &pb.UnreadCountReply{Status: pb.UnreadCountReply_Fail}, resp.Err
+	}
+	return &pb.UnreadCountReply{Count: resp.Count, Status: pb.UnreadCountReply_Success}, nil
+}
+func (g *grpcServer) UnreadCount(ctx context1.Context, req *pb.UnreadCountRequest) (*pb.UnreadCountReply, error) {
+	_, rep, err := g.unreadCount.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UnreadCountReply), nil
+}
+
+func toPBInbox(items []model.Notification) []*pb.InboxItem {
+	out := make([]*pb.InboxItem, 0, len(items))
+	for _, n := range items {
+		out = append(out, &pb.InboxItem{
+			Id:        n.ID,
+			Channel:   n.Channel,
+			Template:  n.Template,
+			Subject:   n.Subject,
+			Body:      n.Body,
+			State:     n.Status,
+			Read:      n.ReadAt != nil,
+			CreatedAt: n.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return out
+}
+
+func makeExportUserHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ExportUserEndpoint, decodeExportUserRequest, encodeExportUserResponse, options...)
+}
+
+func decodeExportUserRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ExportUserRequest)
+	return endpoint.ExportUserRequest{UserID: req.UserID}, nil
+}
+
+func encodeExportUserResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ExportUserResponse)
+	if resp.Err != nil {
+		return &pb.ExportUserReply{Status: pb.ExportUserReply_Fail}, resp.Err
+	}
+	return &pb.ExportUserReply{Data: resp.Data, Status: pb.ExportUserReply_Success}, nil
+}
+func (g *grpcServer) ExportUser(ctx context1.Context, req *pb.ExportUserRequest) (*pb.ExportUserReply, error) {
+	_, rep, err := g.exportUser.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ExportUserReply), nil
+}
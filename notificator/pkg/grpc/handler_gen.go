@@ -9,9 +9,39 @@ import (
 
 // NewGRPCServer makes a set of endpoints available as a gRPC AddServer
 type grpcServer struct {
-	send grpc.Handler
+	send              grpc.Handler
+	getStatus         grpc.Handler
+	createTemplate    grpc.Handler
+	getTemplate       grpc.Handler
+	updateTemplate    grpc.Handler
+	deleteTemplate    grpc.Handler
+	listTemplates     grpc.Handler
+	getPreferences    grpc.Handler
+	updatePreferences grpc.Handler
+	listForRecipient  grpc.Handler
+	markRead          grpc.Handler
+	markAllRead       grpc.Handler
+	unreadCount       grpc.Handler
+	subscribe         grpc.Handler
+	exportUser        grpc.Handler
 }
 
 func NewGRPCServer(endpoints endpoint.Endpoints, options map[string][]grpc.ServerOption) pb.NotificatorServer {
-	return &grpcServer{send: makeSendHandler(endpoints, options["Send"])}
+	return &grpcServer{
+		createTemplate:    makeCreateTemplateHandler(endpoints, options["CreateTemplate"]),
+		deleteTemplate:    makeDeleteTemplateHandler(endpoints, options["DeleteTemplate"]),
+		exportUser:        makeExportUserHandler(endpoints, options["ExportUser"]),
+		getPreferences:    makeGetPreferencesHandler(endpoints, options["GetPreferences"]),
+		getStatus:         makeGetStatusHandler(endpoints, options["GetStatus"]),
+		getTemplate:       makeGetTemplateHandler(endpoints, options["GetTemplate"]),
+		listForRecipient:  makeListForRecipientHandler(endpoints, options["ListForRecipient"]),
+		listTemplates:     makeListTemplatesHandler(endpoints, options["ListTemplates"]),
+		markAllRead:       makeMarkAllReadHandler(endpoints, options["MarkAllRead"]),
+		markRead:          makeMarkReadHandler(endpoints, options["MarkRead"]),
+		send:              makeSendHandler(endpoints, options["Send"]),
+		subscribe:         makeSubscribeHandler(endpoints, options["Subscribe"]),
+		unreadCount:       makeUnreadCountHandler(endpoints, options["UnreadCount"]),
+		updatePreferences: makeUpdatePreferencesHandler(endpoints, options["UpdatePreferences"]),
+		updateTemplate:    makeUpdateTemplateHandler(endpoints, options["UpdateTemplate"]),
+	}
 }
@@ -29,6 +29,55 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type SendRequest_ChannelType int32
+
+const (
+	SendRequest_Auto  SendRequest_ChannelType = 0
+	SendRequest_Email SendRequest_ChannelType = 1
+	SendRequest_Sms   SendRequest_ChannelType = 2
+)
+
+// Enum value maps for SendRequest_ChannelType.
+var (
+	SendRequest_ChannelType_name = map[int32]string{
+		0: "Auto",
+		1: "Email",
+		2: "Sms",
+	}
+	SendRequest_ChannelType_value = map[string]int32{
+		"Auto":  0,
+		"Email": 1,
+		"Sms":   2,
+	}
+)
+
+func (x SendRequest_ChannelType) Enum() *SendRequest_ChannelType {
+	p := new(SendRequest_ChannelType)
+	*p = x
+	return p
+}
+
+func (x SendRequest_ChannelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SendRequest_ChannelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[0].Descriptor()
+}
+
+func (SendRequest_ChannelType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[0]
+}
+
+func (x SendRequest_ChannelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SendRequest_ChannelType.Descriptor instead.
+func (SendRequest_ChannelType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{0, 0}
+}
+
 type SendReply_ReplyType int32
 
 const (
@@ -59,11 +108,11 @@ func (x SendReply_ReplyType) String() string {
 }
 
 func (SendReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_notificator_proto_enumTypes[0].Descriptor()
+	return file_notificator_proto_enumTypes[1].Descriptor()
 }
 
 func (SendReply_ReplyType) Type() protoreflect.EnumType {
-	return &file_notificator_proto_enumTypes[0]
+	return &file_notificator_proto_enumTypes[1]
 }
 
 func (x SendReply_ReplyType) Number() protoreflect.EnumNumber {
@@ -75,263 +124,3714 @@ func (SendReply_ReplyType) EnumDescriptor() ([]byte, []int) {
 	return file_notificator_proto_rawDescGZIP(), []int{1, 0}
 }
 
-type SendRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetStatusReply_ReplyType int32
 
-	To   string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
-	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-}
+const (
+	GetStatusReply_Success GetStatusReply_ReplyType = 0
+	GetStatusReply_Fail    GetStatusReply_ReplyType = 1
+)
 
-func (x *SendRequest) Reset() {
-	*x = SendRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_notificator_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetStatusReply_ReplyType.
+var (
+	GetStatusReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-}
+	GetStatusReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
 
-func (x *SendRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetStatusReply_ReplyType) Enum() *GetStatusReply_ReplyType {
+	p := new(GetStatusReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (*SendRequest) ProtoMessage() {}
+func (x GetStatusReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *SendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notificator_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetStatusReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[2].Descriptor()
 }
 
-// Deprecated: Use SendRequest.ProtoReflect.Descriptor instead.
-func (*SendRequest) Descriptor() ([]byte, []int) {
-	return file_notificator_proto_rawDescGZIP(), []int{0}
+func (GetStatusReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[2]
 }
 
-func (x *SendRequest) GetTo() string {
-	if x != nil {
-		return x.To
-	}
-	return ""
+func (x GetStatusReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *SendRequest) GetBody() string {
-	if x != nil {
-		return x.Body
-	}
-	return ""
+// Deprecated: Use GetStatusReply_ReplyType.Descriptor instead.
+func (GetStatusReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{3, 0}
 }
 
-type SendReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type CreateTemplateReply_ReplyType int32
 
-	Id     string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status SendReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.SendReply_ReplyType" json:"status,omitempty"`
-}
+const (
+	CreateTemplateReply_Success CreateTemplateReply_ReplyType = 0
+	CreateTemplateReply_Fail    CreateTemplateReply_ReplyType = 1
+)
 
-func (x *SendReply) Reset() {
-	*x = SendReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_notificator_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for CreateTemplateReply_ReplyType.
+var (
+	CreateTemplateReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-}
+	CreateTemplateReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
 
-func (x *SendReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CreateTemplateReply_ReplyType) Enum() *CreateTemplateReply_ReplyType {
+	p := new(CreateTemplateReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (*SendReply) ProtoMessage() {}
+func (x CreateTemplateReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *SendReply) ProtoReflect() protoreflect.Message {
-	mi := &file_notificator_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CreateTemplateReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[3].Descriptor()
 }
 
-// Deprecated: Use SendReply.ProtoReflect.Descriptor instead.
-func (*SendReply) Descriptor() ([]byte, []int) {
-	return file_notificator_proto_rawDescGZIP(), []int{1}
+func (CreateTemplateReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[3]
 }
 
-func (x *SendReply) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (x CreateTemplateReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *SendReply) GetStatus() SendReply_ReplyType {
-	if x != nil {
-		return x.Status
-	}
-	return SendReply_Success
+// Deprecated: Use CreateTemplateReply_ReplyType.Descriptor instead.
+func (CreateTemplateReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{6, 0}
 }
 
-var File_notificator_proto protoreflect.FileDescriptor
+type GetTemplateReply_ReplyType int32
 
-var file_notificator_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x31, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x70, 0x0a, 0x09, 0x53, 0x65,
-	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x32, 0x35, 0x0a, 0x0b,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x53,
-	0x65, 0x6e, 0x64, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const (
+	GetTemplateReply_Success GetTemplateReply_ReplyType = 0
+	GetTemplateReply_Fail    GetTemplateReply_ReplyType = 1
+)
 
+// Enum value maps for GetTemplateReply_ReplyType.
 var (
-	file_notificator_proto_rawDescOnce sync.Once
-	file_notificator_proto_rawDescData = file_notificator_proto_rawDesc
+	GetTemplateReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	GetTemplateReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
 )
 
-func file_notificator_proto_rawDescGZIP() []byte {
-	file_notificator_proto_rawDescOnce.Do(func() {
-		file_notificator_proto_rawDescData = protoimpl.X.CompressGZIP(file_notificator_proto_rawDescData)
-	})
-	return file_notificator_proto_rawDescData
+func (x GetTemplateReply_ReplyType) Enum() *GetTemplateReply_ReplyType {
+	p := new(GetTemplateReply_ReplyType)
+	*p = x
+	return p
 }
 
-var file_notificator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_notificator_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_notificator_proto_goTypes = []interface{}{
-	(SendReply_ReplyType)(0), // 0: pb.SendReply.ReplyType
-	(*SendRequest)(nil),      // 1: pb.SendRequest
-	(*SendReply)(nil),        // 2: pb.SendReply
-}
-var file_notificator_proto_depIdxs = []int32{
-	0, // 0: pb.SendReply.status:type_name -> pb.SendReply.ReplyType
-	1, // 1: pb.Notificator.Send:input_type -> pb.SendRequest
-	2, // 2: pb.Notificator.Send:output_type -> pb.SendReply
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+func (x GetTemplateReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func init() { file_notificator_proto_init() }
-func file_notificator_proto_init() {
-	if File_notificator_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_notificator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_notificator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_notificator_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_notificator_proto_goTypes,
-		DependencyIndexes: file_notificator_proto_depIdxs,
-		EnumInfos:         file_notificator_proto_enumTypes,
-		MessageInfos:      file_notificator_proto_msgTypes,
-	}.Build()
-	File_notificator_proto = out.File
-	file_notificator_proto_rawDesc = nil
-	file_notificator_proto_goTypes = nil
-	file_notificator_proto_depIdxs = nil
+func (GetTemplateReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[4].Descriptor()
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
-
-// NotificatorClient is the client API for Notificator service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NotificatorClient interface {
-	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error)
+func (GetTemplateReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[4]
 }
 
-type notificatorClient struct {
-	cc grpc.ClientConnInterface
+func (x GetTemplateReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func NewNotificatorClient(cc grpc.ClientConnInterface) NotificatorClient {
-	return &notificatorClient{cc}
+// Deprecated: Use GetTemplateReply_ReplyType.Descriptor instead.
+func (GetTemplateReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{8, 0}
 }
 
-func (c *notificatorClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error) {
-	out := new(SendReply)
-	err := c.cc.Invoke(ctx, "/pb.Notificator/Send", in, out, opts...)
-	if err != nil {
-		return nil, err
+type UpdateTemplateReply_ReplyType int32
+
+const (
+	UpdateTemplateReply_Success UpdateTemplateReply_ReplyType = 0
+	UpdateTemplateReply_Fail    UpdateTemplateReply_ReplyType = 1
+)
+
+// Enum value maps for UpdateTemplateReply_ReplyType.
+var (
+	UpdateTemplateReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-	return out, nil
+	UpdateTemplateReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x UpdateTemplateReply_ReplyType) Enum() *UpdateTemplateReply_ReplyType {
+	p := new(UpdateTemplateReply_ReplyType)
+	*p = x
+	return p
 }
 
-// NotificatorServer is the server API for Notificator service.
-type NotificatorServer interface {
-	Send(context.Context, *SendRequest) (*SendReply, error)
+func (x UpdateTemplateReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// UnimplementedNotificatorServer can be embedded to have forward compatible implementations.
-type UnimplementedNotificatorServer struct {
+func (UpdateTemplateReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[5].Descriptor()
 }
 
-func (*UnimplementedNotificatorServer) Send(context.Context, *SendRequest) (*SendReply, error) {
+func (UpdateTemplateReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[5]
+}
+
+func (x UpdateTemplateReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateTemplateReply_ReplyType.Descriptor instead.
+func (UpdateTemplateReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type DeleteTemplateReply_ReplyType int32
+
+const (
+	DeleteTemplateReply_Success DeleteTemplateReply_ReplyType = 0
+	DeleteTemplateReply_Fail    DeleteTemplateReply_ReplyType = 1
+)
+
+// Enum value maps for DeleteTemplateReply_ReplyType.
+var (
+	DeleteTemplateReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	DeleteTemplateReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x DeleteTemplateReply_ReplyType) Enum() *DeleteTemplateReply_ReplyType {
+	p := new(DeleteTemplateReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x DeleteTemplateReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteTemplateReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[6].Descriptor()
+}
+
+func (DeleteTemplateReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[6]
+}
+
+func (x DeleteTemplateReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteTemplateReply_ReplyType.Descriptor instead.
+func (DeleteTemplateReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type ListTemplatesReply_ReplyType int32
+
+const (
+	ListTemplatesReply_Success ListTemplatesReply_ReplyType = 0
+	ListTemplatesReply_Fail    ListTemplatesReply_ReplyType = 1
+)
+
+// Enum value maps for ListTemplatesReply_ReplyType.
+var (
+	ListTemplatesReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ListTemplatesReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ListTemplatesReply_ReplyType) Enum() *ListTemplatesReply_ReplyType {
+	p := new(ListTemplatesReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ListTemplatesReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListTemplatesReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[7].Descriptor()
+}
+
+func (ListTemplatesReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[7]
+}
+
+func (x ListTemplatesReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListTemplatesReply_ReplyType.Descriptor instead.
+func (ListTemplatesReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type GetPreferencesReply_ReplyType int32
+
+const (
+	GetPreferencesReply_Success GetPreferencesReply_ReplyType = 0
+	GetPreferencesReply_Fail    GetPreferencesReply_ReplyType = 1
+)
+
+// Enum value maps for GetPreferencesReply_ReplyType.
+var (
+	GetPreferencesReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	GetPreferencesReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x GetPreferencesReply_ReplyType) Enum() *GetPreferencesReply_ReplyType {
+	p := new(GetPreferencesReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x GetPreferencesReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetPreferencesReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[8].Descriptor()
+}
+
+func (GetPreferencesReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[8]
+}
+
+func (x GetPreferencesReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetPreferencesReply_ReplyType.Descriptor instead.
+func (GetPreferencesReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type UpdatePreferencesReply_ReplyType int32
+
+const (
+	UpdatePreferencesReply_Success UpdatePreferencesReply_ReplyType = 0
+	UpdatePreferencesReply_Fail    UpdatePreferencesReply_ReplyType = 1
+)
+
+// Enum value maps for UpdatePreferencesReply_ReplyType.
+var (
+	UpdatePreferencesReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	UpdatePreferencesReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x UpdatePreferencesReply_ReplyType) Enum() *UpdatePreferencesReply_ReplyType {
+	p := new(UpdatePreferencesReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x UpdatePreferencesReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdatePreferencesReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[9].Descriptor()
+}
+
+func (UpdatePreferencesReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[9]
+}
+
+func (x UpdatePreferencesReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdatePreferencesReply_ReplyType.Descriptor instead.
+func (UpdatePreferencesReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type ListForRecipientReply_ReplyType int32
+
+const (
+	ListForRecipientReply_Success ListForRecipientReply_ReplyType = 0
+	ListForRecipientReply_Fail    ListForRecipientReply_ReplyType = 1
+)
+
+// Enum value maps for ListForRecipientReply_ReplyType.
+var (
+	ListForRecipientReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ListForRecipientReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ListForRecipientReply_ReplyType) Enum() *ListForRecipientReply_ReplyType {
+	p := new(ListForRecipientReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ListForRecipientReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListForRecipientReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[10].Descriptor()
+}
+
+func (ListForRecipientReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[10]
+}
+
+func (x ListForRecipientReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListForRecipientReply_ReplyType.Descriptor instead.
+func (ListForRecipientReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{23, 0}
+}
+
+type MarkReadReply_ReplyType int32
+
+const (
+	MarkReadReply_Success MarkReadReply_ReplyType = 0
+	MarkReadReply_Fail    MarkReadReply_ReplyType = 1
+)
+
+// Enum value maps for MarkReadReply_ReplyType.
+var (
+	MarkReadReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	MarkReadReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x MarkReadReply_ReplyType) Enum() *MarkReadReply_ReplyType {
+	p := new(MarkReadReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x MarkReadReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarkReadReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[11].Descriptor()
+}
+
+func (MarkReadReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[11]
+}
+
+func (x MarkReadReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarkReadReply_ReplyType.Descriptor instead.
+func (MarkReadReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{25, 0}
+}
+
+type MarkAllReadReply_ReplyType int32
+
+const (
+	MarkAllReadReply_Success MarkAllReadReply_ReplyType = 0
+	MarkAllReadReply_Fail    MarkAllReadReply_ReplyType = 1
+)
+
+// Enum value maps for MarkAllReadReply_ReplyType.
+var (
+	MarkAllReadReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	MarkAllReadReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x MarkAllReadReply_ReplyType) Enum() *MarkAllReadReply_ReplyType {
+	p := new(MarkAllReadReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x MarkAllReadReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarkAllReadReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[12].Descriptor()
+}
+
+func (MarkAllReadReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[12]
+}
+
+func (x MarkAllReadReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarkAllReadReply_ReplyType.Descriptor instead.
+func (MarkAllReadReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type UnreadCountReply_ReplyType int32
+
+const (
+	UnreadCountReply_Success UnreadCountReply_ReplyType = 0
+	UnreadCountReply_Fail    UnreadCountReply_ReplyType = 1
+)
+
+// Enum value maps for UnreadCountReply_ReplyType.
+var (
+	UnreadCountReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	UnreadCountReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x UnreadCountReply_ReplyType) Enum() *UnreadCountReply_ReplyType {
+	p := new(UnreadCountReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x UnreadCountReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnreadCountReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[13].Descriptor()
+}
+
+func (UnreadCountReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[13]
+}
+
+func (x UnreadCountReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnreadCountReply_ReplyType.Descriptor instead.
+func (UnreadCountReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{29, 0}
+}
+
+type ExportUserReply_ReplyType int32
+
+const (
+	ExportUserReply_Success ExportUserReply_ReplyType = 0
+	ExportUserReply_Fail    ExportUserReply_ReplyType = 1
+)
+
+// Enum value maps for ExportUserReply_ReplyType.
+var (
+	ExportUserReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ExportUserReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ExportUserReply_ReplyType) Enum() *ExportUserReply_ReplyType {
+	p := new(ExportUserReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ExportUserReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportUserReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificator_proto_enumTypes[14].Descriptor()
+}
+
+func (ExportUserReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_notificator_proto_enumTypes[14]
+}
+
+func (x ExportUserReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportUserReply_ReplyType.Descriptor instead.
+func (ExportUserReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type SendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	To         string                  `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Body       string                  `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Channel    SendRequest_ChannelType `protobuf:"varint,3,opt,name=channel,proto3,enum=pb.SendRequest_ChannelType" json:"channel,omitempty"`
+	Subject    string                  `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	TemplateID string                  `protobuf:"bytes,5,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	Locale     string                  `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	Variables  map[string]string       `protobuf:"bytes,7,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	UserID     string                  `protobuf:"bytes,8,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *SendRequest) Reset() {
+	*x = SendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendRequest) ProtoMessage() {}
+
+func (x *SendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendRequest.ProtoReflect.Descriptor instead.
+func (*SendRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SendRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SendRequest) GetChannel() SendRequest_ChannelType {
+	if x != nil {
+		return x.Channel
+	}
+	return SendRequest_Auto
+}
+
+func (x *SendRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SendRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *SendRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SendRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *SendRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type SendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status SendReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.SendReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *SendReply) Reset() {
+	*x = SendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendReply) ProtoMessage() {}
+
+func (x *SendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendReply.ProtoReflect.Descriptor instead.
+func (*SendReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SendReply) GetStatus() SendReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return SendReply_Success
+}
+
+type GetStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetStatusRequest) Reset() {
+	*x = GetStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusRequest) ProtoMessage() {}
+
+func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetStatusReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State     string                   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Attempts  int32                    `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string                   `protobuf:"bytes,4,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	Channel   string                   `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	UpdatedAt string                   `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Status    GetStatusReply_ReplyType `protobuf:"varint,7,opt,name=status,proto3,enum=pb.GetStatusReply_ReplyType" json:"status,omitempty"`
+	Reason    string                   `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *GetStatusReply) Reset() {
+	*x = GetStatusReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusReply) ProtoMessage() {}
+
+func (x *GetStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusReply.ProtoReflect.Descriptor instead.
+func (*GetStatusReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetStatusReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetStatusReply) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GetStatusReply) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *GetStatusReply) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *GetStatusReply) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GetStatusReply) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *GetStatusReply) GetStatus() GetStatusReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return GetStatusReply_Success
+}
+
+func (x *GetStatusReply) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Template struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Locale  string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	Subject string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body    string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Html    bool   `protobuf:"varint,6,opt,name=html,proto3" json:"html,omitempty"`
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Template) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Template) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Template) GetHtml() bool {
+	if x != nil {
+		return x.Html
+	}
+	return false
+}
+
+type CreateTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *Template `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTemplateRequest) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateTemplateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status CreateTemplateReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.CreateTemplateReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *CreateTemplateReply) Reset() {
+	*x = CreateTemplateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTemplateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateReply) ProtoMessage() {}
+
+func (x *CreateTemplateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateReply.ProtoReflect.Descriptor instead.
+func (*CreateTemplateReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTemplateReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateTemplateReply) GetStatus() CreateTemplateReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return CreateTemplateReply_Success
+}
+
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTemplateRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetTemplateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *Template                  `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Status   GetTemplateReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.GetTemplateReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *GetTemplateReply) Reset() {
+	*x = GetTemplateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTemplateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateReply) ProtoMessage() {}
+
+func (x *GetTemplateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateReply.ProtoReflect.Descriptor instead.
+func (*GetTemplateReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTemplateReply) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *GetTemplateReply) GetStatus() GetTemplateReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return GetTemplateReply_Success
+}
+
+type UpdateTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *Template `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateTemplateRequest) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateTemplateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status UpdateTemplateReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.UpdateTemplateReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *UpdateTemplateReply) Reset() {
+	*x = UpdateTemplateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTemplateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateReply) ProtoMessage() {}
+
+func (x *UpdateTemplateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateReply.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTemplateReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTemplateReply) GetStatus() UpdateTemplateReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return UpdateTemplateReply_Success
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteTemplateRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type DeleteTemplateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status DeleteTemplateReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.DeleteTemplateReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *DeleteTemplateReply) Reset() {
+	*x = DeleteTemplateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTemplateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateReply) ProtoMessage() {}
+
+func (x *DeleteTemplateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateReply.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteTemplateReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTemplateReply) GetStatus() DeleteTemplateReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteTemplateReply_Success
+}
+
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTemplatesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListTemplatesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*Template                  `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Status    ListTemplatesReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ListTemplatesReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ListTemplatesReply) Reset() {
+	*x = ListTemplatesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTemplatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesReply) ProtoMessage() {}
+
+func (x *ListTemplatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesReply.ProtoReflect.Descriptor instead.
+func (*ListTemplatesReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTemplatesReply) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListTemplatesReply) GetStatus() ListTemplatesReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ListTemplatesReply_Success
+}
+
+type QuietHours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End      string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *QuietHours) Reset() {
+	*x = QuietHours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHours) ProtoMessage() {}
+
+func (x *QuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHours.ProtoReflect.Descriptor instead.
+func (*QuietHours) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QuietHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *QuietHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *QuietHours) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type Preferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string          `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Events     map[string]bool `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Channels   map[string]bool `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	QuietHours *QuietHours     `protobuf:"bytes,4,opt,name=quietHours,proto3" json:"quietHours,omitempty"`
+	UpdatedAt  string          `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *Preferences) Reset() {
+	*x = Preferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Preferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preferences) ProtoMessage() {}
+
+func (x *Preferences) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Preferences) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Preferences) GetEvents() map[string]bool {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Preferences) GetChannels() map[string]bool {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Preferences) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *Preferences) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPreferencesRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetPreferencesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences *Preferences                  `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Status      GetPreferencesReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.GetPreferencesReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *GetPreferencesReply) Reset() {
+	*x = GetPreferencesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPreferencesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesReply) ProtoMessage() {}
+
+func (x *GetPreferencesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesReply.ProtoReflect.Descriptor instead.
+func (*GetPreferencesReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPreferencesReply) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *GetPreferencesReply) GetStatus() GetPreferencesReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return GetPreferencesReply_Success
+}
+
+type UpdatePreferencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences *Preferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdatePreferencesRequest) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdatePreferencesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences *Preferences                     `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Status      UpdatePreferencesReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.UpdatePreferencesReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *UpdatePreferencesReply) Reset() {
+	*x = UpdatePreferencesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePreferencesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesReply) ProtoMessage() {}
+
+func (x *UpdatePreferencesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesReply.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdatePreferencesReply) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdatePreferencesReply) GetStatus() UpdatePreferencesReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return UpdatePreferencesReply_Success
+}
+
+type InboxItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Channel   string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Template  string `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Subject   string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body      string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	State     string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Read      bool   `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *InboxItem) Reset() {
+	*x = InboxItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InboxItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxItem) ProtoMessage() {}
+
+func (x *InboxItem) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxItem.ProtoReflect.Descriptor instead.
+func (*InboxItem) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InboxItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InboxItem) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *InboxItem) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *InboxItem) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *InboxItem) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *InboxItem) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *InboxItem) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *InboxItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListForRecipientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Limit      int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor     string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	UnreadOnly bool   `protobuf:"varint,4,opt,name=unreadOnly,proto3" json:"unreadOnly,omitempty"`
+}
+
+func (x *ListForRecipientRequest) Reset() {
+	*x = ListForRecipientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListForRecipientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForRecipientRequest) ProtoMessage() {}
+
+func (x *ListForRecipientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForRecipientRequest.ProtoReflect.Descriptor instead.
+func (*ListForRecipientRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListForRecipientRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ListForRecipientRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListForRecipientRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListForRecipientRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type ListForRecipientReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*InboxItem                    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor string                          `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	Status     ListForRecipientReply_ReplyType `protobuf:"varint,3,opt,name=status,proto3,enum=pb.ListForRecipientReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ListForRecipientReply) Reset() {
+	*x = ListForRecipientReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListForRecipientReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForRecipientReply) ProtoMessage() {}
+
+func (x *ListForRecipientReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForRecipientReply.ProtoReflect.Descriptor instead.
+func (*ListForRecipientReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListForRecipientReply) GetItems() []*InboxItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListForRecipientReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListForRecipientReply) GetStatus() ListForRecipientReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ListForRecipientReply_Success
+}
+
+type MarkReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MarkReadRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MarkReadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MarkReadReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status MarkReadReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.MarkReadReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *MarkReadReply) Reset() {
+	*x = MarkReadReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkReadReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadReply) ProtoMessage() {}
+
+func (x *MarkReadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadReply.ProtoReflect.Descriptor instead.
+func (*MarkReadReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MarkReadReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkReadReply) GetStatus() MarkReadReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return MarkReadReply_Success
+}
+
+type MarkAllReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *MarkAllReadRequest) Reset() {
+	*x = MarkAllReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkAllReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllReadRequest) ProtoMessage() {}
+
+func (x *MarkAllReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllReadRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MarkAllReadRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type MarkAllReadReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int64                      `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Status MarkAllReadReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.MarkAllReadReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *MarkAllReadReply) Reset() {
+	*x = MarkAllReadReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkAllReadReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllReadReply) ProtoMessage() {}
+
+func (x *MarkAllReadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllReadReply.ProtoReflect.Descriptor instead.
+func (*MarkAllReadReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MarkAllReadReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MarkAllReadReply) GetStatus() MarkAllReadReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return MarkAllReadReply_Success
+}
+
+type UnreadCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *UnreadCountRequest) Reset() {
+	*x = UnreadCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountRequest) ProtoMessage() {}
+
+func (x *UnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*UnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnreadCountRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type UnreadCountReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int64                      `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Status UnreadCountReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.UnreadCountReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *UnreadCountReply) Reset() {
+	*x = UnreadCountReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreadCountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCountReply) ProtoMessage() {}
+
+func (x *UnreadCountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCountReply.ProtoReflect.Descriptor instead.
+func (*UnreadCountReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnreadCountReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UnreadCountReply) GetStatus() UnreadCountReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return UnreadCountReply_Success
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{30}
+}
+
+// ExportUser is called by the users service for the data export of a user.
+type ExportUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ExportUserRequest) Reset() {
+	*x = ExportUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserRequest) ProtoMessage() {}
+
+func (x *ExportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserRequest) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ExportUserReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is a JSON document of the notifications and preferences of the user
+	Data   []byte                    `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Status ExportUserReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ExportUserReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ExportUserReply) Reset() {
+	*x = ExportUserReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificator_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserReply) ProtoMessage() {}
+
+func (x *ExportUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificator_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserReply.ProtoReflect.Descriptor instead.
+func (*ExportUserReply) Descriptor() ([]byte, []int) {
+	return file_notificator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExportUserReply) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportUserReply) GetStatus() ExportUserReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ExportUserReply_Success
+}
+
+var File_notificator_proto protoreflect.FileDescriptor
+
+var file_notificator_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0xfb, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2b, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x6f, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
+	0x53, 0x6d, 0x73, 0x10, 0x02, 0x22, 0x70, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9a, 0x02, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x88, 0x01, 0x0a, 0x08, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68,
+	0x74, 0x6d, 0x6c, 0x22, 0x41, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x40, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x98, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x36,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x41, 0x0a, 0x15, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x84, 0x01,
+	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61,
+	0x69, 0x6c, 0x10, 0x01, 0x22, 0x43, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01,
+	0x22, 0x2a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9e, 0x01, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x50, 0x0a,
+	0x0a, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22,
+	0xdb, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x71, 0x75, 0x69, 0x65, 0x74,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x0a, 0x71, 0x75, 0x69,
+	0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xa7,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x4d, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x62, 0x6f,
+	0x78, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x7f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0xbd, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22,
+	0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c,
+	0x10, 0x01, 0x22, 0x39, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x78, 0x0a,
+	0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x2c, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x2c, 0x0a, 0x12,
+	0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a,
+	0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10,
+	0x01, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46,
+	0x61, 0x69, 0x6c, 0x10, 0x01, 0x32, 0xbb, 0x07, 0x0a, 0x0b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x44, 0x0a,
+	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x4d, 0x61, 0x72, 0x6b, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x4d,
+	0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x55, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x32, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x49, 0x74, 0x65,
+	0x6d, 0x30, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_notificator_proto_rawDescOnce sync.Once
+	file_notificator_proto_rawDescData = file_notificator_proto_rawDesc
+)
+
+func file_notificator_proto_rawDescGZIP() []byte {
+	file_notificator_proto_rawDescOnce.Do(func() {
+		file_notificator_proto_rawDescData = protoimpl.X.CompressGZIP(file_notificator_proto_rawDescData)
+	})
+	return file_notificator_proto_rawDescData
+}
+
+var file_notificator_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_notificator_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_notificator_proto_goTypes = []interface{}{
+	(SendRequest_ChannelType)(0),          // 0: pb.SendRequest.ChannelType
+	(SendReply_ReplyType)(0),              // 1: pb.SendReply.ReplyType
+	(GetStatusReply_ReplyType)(0),         // 2: pb.GetStatusReply.ReplyType
+	(CreateTemplateReply_ReplyType)(0),    // 3: pb.CreateTemplateReply.ReplyType
+	(GetTemplateReply_ReplyType)(0),       // 4: pb.GetTemplateReply.ReplyType
+	(UpdateTemplateReply_ReplyType)(0),    // 5: pb.UpdateTemplateReply.ReplyType
+	(DeleteTemplateReply_ReplyType)(0),    // 6: pb.DeleteTemplateReply.ReplyType
+	(ListTemplatesReply_ReplyType)(0),     // 7: pb.ListTemplatesReply.ReplyType
+	(GetPreferencesReply_ReplyType)(0),    // 8: pb.GetPreferencesReply.ReplyType
+	(UpdatePreferencesReply_ReplyType)(0), // 9: pb.UpdatePreferencesReply.ReplyType
+	(ListForRecipientReply_ReplyType)(0),  // 10: pb.ListForRecipientReply.ReplyType
+	(MarkReadReply_ReplyType)(0),          // 11: pb.MarkReadReply.ReplyType
+	(MarkAllReadReply_ReplyType)(0),       // 12: pb.MarkAllReadReply.ReplyType
+	(UnreadCountReply_ReplyType)(0),       // 13: pb.UnreadCountReply.ReplyType
+	(ExportUserReply_ReplyType)(0),        // 14: pb.ExportUserReply.ReplyType
+	(*SendRequest)(nil),                   // 15: pb.SendRequest
+	(*SendReply)(nil),                     // 16: pb.SendReply
+	(*GetStatusRequest)(nil),              // 17: pb.GetStatusRequest
+	(*GetStatusReply)(nil),                // 18: pb.GetStatusReply
+	(*Template)(nil),                      // 19: pb.Template
+	(*CreateTemplateRequest)(nil),         // 20: pb.CreateTemplateRequest
+	(*CreateTemplateReply)(nil),           // 21: pb.CreateTemplateReply
+	(*GetTemplateRequest)(nil),            // 22: pb.GetTemplateRequest
+	(*GetTemplateReply)(nil),              // 23: pb.GetTemplateReply
+	(*UpdateTemplateRequest)(nil),         // 24: pb.UpdateTemplateRequest
+	(*UpdateTemplateReply)(nil),           // 25: pb.UpdateTemplateReply
+	(*DeleteTemplateRequest)(nil),         // 26: pb.DeleteTemplateRequest
+	(*DeleteTemplateReply)(nil),           // 27: pb.DeleteTemplateReply
+	(*ListTemplatesRequest)(nil),          // 28: pb.ListTemplatesRequest
+	(*ListTemplatesReply)(nil),            // 29: pb.ListTemplatesReply
+	(*QuietHours)(nil),                    // 30: pb.QuietHours
+	(*Preferences)(nil),                   // 31: pb.Preferences
+	(*GetPreferencesRequest)(nil),         // 32: pb.GetPreferencesRequest
+	(*GetPreferencesReply)(nil),           // 33: pb.GetPreferencesReply
+	(*UpdatePreferencesRequest)(nil),      // 34: pb.UpdatePreferencesRequest
+	(*UpdatePreferencesReply)(nil),        // 35: pb.UpdatePreferencesReply
+	(*InboxItem)(nil),                     // 36: pb.InboxItem
+	(*ListForRecipientRequest)(nil),       // 37: pb.ListForRecipientRequest
+	(*ListForRecipientReply)(nil),         // 38: pb.ListForRecipientReply
+	(*MarkReadRequest)(nil),               // 39: pb.MarkReadRequest
+	(*MarkReadReply)(nil),                 // 40: pb.MarkReadReply
+	(*MarkAllReadRequest)(nil),            // 41: pb.MarkAllReadRequest
+	(*MarkAllReadReply)(nil),              // 42: pb.MarkAllReadReply
+	(*UnreadCountRequest)(nil),            // 43: pb.UnreadCountRequest
+	(*UnreadCountReply)(nil),              // 44: pb.UnreadCountReply
+	(*SubscribeRequest)(nil),              // 45: pb.SubscribeRequest
+	(*ExportUserRequest)(nil),             // 46: pb.ExportUserRequest
+	(*ExportUserReply)(nil),               // 47: pb.ExportUserReply
+	nil,                                   // 48: pb.SendRequest.VariablesEntry
+	nil,                                   // 49: pb.Preferences.EventsEntry
+	nil,                                   // 50: pb.Preferences.ChannelsEntry
+}
+var file_notificator_proto_depIdxs = []int32{
+	0,  // 0: pb.SendRequest.channel:type_name -> pb.SendRequest.ChannelType
+	48, // 1: pb.SendRequest.variables:type_name -> pb.SendRequest.VariablesEntry
+	1,  // 2: pb.SendReply.status:type_name -> pb.SendReply.ReplyType
+	2,  // 3: pb.GetStatusReply.status:type_name -> pb.GetStatusReply.ReplyType
+	19, // 4: pb.CreateTemplateRequest.template:type_name -> pb.Template
+	3,  // 5: pb.CreateTemplateReply.status:type_name -> pb.CreateTemplateReply.ReplyType
+	19, // 6: pb.GetTemplateReply.template:type_name -> pb.Template
+	4,  // 7: pb.GetTemplateReply.status:type_name -> pb.GetTemplateReply.ReplyType
+	19, // 8: pb.UpdateTemplateRequest.template:type_name -> pb.Template
+	5,  // 9: pb.UpdateTemplateReply.status:type_name -> pb.UpdateTemplateReply.ReplyType
+	6,  // 10: pb.DeleteTemplateReply.status:type_name -> pb.DeleteTemplateReply.ReplyType
+	19, // 11: pb.ListTemplatesReply.templates:type_name -> pb.Template
+	7,  // 12: pb.ListTemplatesReply.status:type_name -> pb.ListTemplatesReply.ReplyType
+	49, // 13: pb.Preferences.events:type_name -> pb.Preferences.EventsEntry
+	50, // 14: pb.Preferences.channels:type_name -> pb.Preferences.ChannelsEntry
+	30, // 15: pb.Preferences.quietHours:type_name -> pb.QuietHours
+	31, // 16: pb.GetPreferencesReply.preferences:type_name -> pb.Preferences
+	8,  // 17: pb.GetPreferencesReply.status:type_name -> pb.GetPreferencesReply.ReplyType
+	31, // 18: pb.UpdatePreferencesRequest.preferences:type_name -> pb.Preferences
+	31, // 19: pb.UpdatePreferencesReply.preferences:type_name -> pb.Preferences
+	9,  // 20: pb.UpdatePreferencesReply.status:type_name -> pb.UpdatePreferencesReply.ReplyType
+	36, // 21: pb.ListForRecipientReply.items:type_name -> pb.InboxItem
+	10, // 22: pb.ListForRecipientReply.status:type_name -> pb.ListForRecipientReply.ReplyType
+	11, // 23: pb.MarkReadReply.status:type_name -> pb.MarkReadReply.ReplyType
+	12, // 24: pb.MarkAllReadReply.status:type_name -> pb.MarkAllReadReply.ReplyType
+	13, // 25: pb.UnreadCountReply.status:type_name -> pb.UnreadCountReply.ReplyType
+	14, // 26: pb.ExportUserReply.status:type_name -> pb.ExportUserReply.ReplyType
+	15, // 27: pb.Notificator.Send:input_type -> pb.SendRequest
+	17, // 28: pb.Notificator.GetStatus:input_type -> pb.GetStatusRequest
+	20, // 29: pb.Notificator.CreateTemplate:input_type -> pb.CreateTemplateRequest
+	22, // 30: pb.Notificator.GetTemplate:input_type -> pb.GetTemplateRequest
+	24, // 31: pb.Notificator.UpdateTemplate:input_type -> pb.UpdateTemplateRequest
+	26, // 32: pb.Notificator.DeleteTemplate:input_type -> pb.DeleteTemplateRequest
+	28, // 33: pb.Notificator.ListTemplates:input_type -> pb.ListTemplatesRequest
+	32, // 34: pb.Notificator.GetPreferences:input_type -> pb.GetPreferencesRequest
+	34, // 35: pb.Notificator.UpdatePreferences:input_type -> pb.UpdatePreferencesRequest
+	37, // 36: pb.Notificator.ListForRecipient:input_type -> pb.ListForRecipientRequest
+	39, // 37: pb.Notificator.MarkRead:input_type -> pb.MarkReadRequest
+	41, // 38: pb.Notificator.MarkAllRead:input_type -> pb.MarkAllReadRequest
+	43, // 39: pb.Notificator.UnreadCount:input_type -> pb.UnreadCountRequest
+	46, // 40: pb.Notificator.ExportUser:input_type -> pb.ExportUserRequest
+	45, // 41: pb.Notificator.Subscribe:input_type -> pb.SubscribeRequest
+	16, // 42: pb.Notificator.Send:output_type -> pb.SendReply
+	18, // 43: pb.Notificator.GetStatus:output_type -> pb.GetStatusReply
+	21, // 44: pb.Notificator.CreateTemplate:output_type -> pb.CreateTemplateReply
+	23, // 45: pb.Notificator.GetTemplate:output_type -> pb.GetTemplateReply
+	25, // 46: pb.Notificator.UpdateTemplate:output_type -> pb.UpdateTemplateReply
+	27, // 47: pb.Notificator.DeleteTemplate:output_type -> pb.DeleteTemplateReply
+	29, // 48: pb.Notificator.ListTemplates:output_type -> pb.ListTemplatesReply
+	33, // 49: pb.Notificator.GetPreferences:output_type -> pb.GetPreferencesReply
+	35, // 50: pb.Notificator.UpdatePreferences:output_type -> pb.UpdatePreferencesReply
+	38, // 51: pb.Notificator.ListForRecipient:output_type -> pb.ListForRecipientReply
+	40, // 52: pb.Notificator.MarkRead:output_type -> pb.MarkReadReply
+	42, // 53: pb.Notificator.MarkAllRead:output_type -> pb.MarkAllReadReply
+	44, // 54: pb.Notificator.UnreadCount:output_type -> pb.UnreadCountReply
+	47, // 55: pb.Notificator.ExportUser:output_type -> pb.ExportUserReply
+	36, // 56: pb.Notificator.Subscribe:output_type -> pb.InboxItem
+	42, // [42:57] is the sub-list for method output_type
+	27, // [27:42] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_notificator_proto_init() }
+func file_notificator_proto_init() {
+	if File_notificator_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_notificator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Template); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTemplateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTemplateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTemplateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTemplateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTemplatesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuietHours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Preferences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPreferencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPreferencesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePreferencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePreferencesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InboxItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListForRecipientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListForRecipientReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkReadReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkAllReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkAllReadReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreadCountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreadCountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_notificator_proto_rawDesc,
+			NumEnums:      15,
+			NumMessages:   36,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notificator_proto_goTypes,
+		DependencyIndexes: file_notificator_proto_depIdxs,
+		EnumInfos:         file_notificator_proto_enumTypes,
+		MessageInfos:      file_notificator_proto_msgTypes,
+	}.Build()
+	File_notificator_proto = out.File
+	file_notificator_proto_rawDesc = nil
+	file_notificator_proto_goTypes = nil
+	file_notificator_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// NotificatorClient is the client API for Notificator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NotificatorClient interface {
+	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error)
+	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusReply, error)
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateReply, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateReply, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateReply, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateReply, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesReply, error)
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesReply, error)
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesReply, error)
+	ListForRecipient(ctx context.Context, in *ListForRecipientRequest, opts ...grpc.CallOption) (*ListForRecipientReply, error)
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadReply, error)
+	MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...grpc.CallOption) (*MarkAllReadReply, error)
+	UnreadCount(ctx context.Context, in *UnreadCountRequest, opts ...grpc.CallOption) (*UnreadCountReply, error)
+	ExportUser(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (*ExportUserReply, error)
+	// Subscribe streams new notifications of the caller, authenticated by the
+	// access token in the "authorization" metadata.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Notificator_SubscribeClient, error)
+}
+
+type notificatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificatorClient(cc grpc.ClientConnInterface) NotificatorClient {
+	return &notificatorClient{cc}
+}
+
+func (c *notificatorClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error) {
+	out := new(SendReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusReply, error) {
+	out := new(GetStatusReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateReply, error) {
+	out := new(CreateTemplateReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/CreateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateReply, error) {
+	out := new(GetTemplateReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/GetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateReply, error) {
+	out := new(UpdateTemplateReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/UpdateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateReply, error) {
+	out := new(DeleteTemplateReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/DeleteTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesReply, error) {
+	out := new(ListTemplatesReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/ListTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesReply, error) {
+	out := new(GetPreferencesReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/GetPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesReply, error) {
+	out := new(UpdatePreferencesReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/UpdatePreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) ListForRecipient(ctx context.Context, in *ListForRecipientRequest, opts ...grpc.CallOption) (*ListForRecipientReply, error) {
+	out := new(ListForRecipientReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/ListForRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadReply, error) {
+	out := new(MarkReadReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/MarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...grpc.CallOption) (*MarkAllReadReply, error) {
+	out := new(MarkAllReadReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/MarkAllRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) UnreadCount(ctx context.Context, in *UnreadCountRequest, opts ...grpc.CallOption) (*UnreadCountReply, error) {
+	out := new(UnreadCountReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/UnreadCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) ExportUser(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (*ExportUserReply, error) {
+	out := new(ExportUserReply)
+	err := c.cc.Invoke(ctx, "/pb.Notificator/ExportUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificatorClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Notificator_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Notificator_serviceDesc.Streams[0], "/pb.Notificator/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificatorSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Notificator_SubscribeClient interface {
+	Recv() (*InboxItem, error)
+	grpc.ClientStream
+}
+
+type notificatorSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificatorSubscribeClient) Recv() (*InboxItem, error) {
+	m := new(InboxItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NotificatorServer is the server API for Notificator service.
+type NotificatorServer interface {
+	Send(context.Context, *SendRequest) (*SendReply, error)
+	GetStatus(context.Context, *GetStatusRequest) (*GetStatusReply, error)
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateReply, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateReply, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateReply, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateReply, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesReply, error)
+	GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesReply, error)
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesReply, error)
+	ListForRecipient(context.Context, *ListForRecipientRequest) (*ListForRecipientReply, error)
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadReply, error)
+	MarkAllRead(context.Context, *MarkAllReadRequest) (*MarkAllReadReply, error)
+	UnreadCount(context.Context, *UnreadCountRequest) (*UnreadCountReply, error)
+	ExportUser(context.Context, *ExportUserRequest) (*ExportUserReply, error)
+	// Subscribe streams new notifications of the caller, authenticated by the
+	// access token in the "authorization" metadata.
+	Subscribe(*SubscribeRequest, Notificator_SubscribeServer) error
+}
+
+// UnimplementedNotificatorServer can be embedded to have forward compatible implementations.
+type UnimplementedNotificatorServer struct {
+}
+
+func (*UnimplementedNotificatorServer) Send(context.Context, *SendRequest) (*SendReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (*UnimplementedNotificatorServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (*UnimplementedNotificatorServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (*UnimplementedNotificatorServer) GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (*UnimplementedNotificatorServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (*UnimplementedNotificatorServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (*UnimplementedNotificatorServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (*UnimplementedNotificatorServer) GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (*UnimplementedNotificatorServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (*UnimplementedNotificatorServer) ListForRecipient(context.Context, *ListForRecipientRequest) (*ListForRecipientReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForRecipient not implemented")
+}
+func (*UnimplementedNotificatorServer) MarkRead(context.Context, *MarkReadRequest) (*MarkReadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (*UnimplementedNotificatorServer) MarkAllRead(context.Context, *MarkAllReadRequest) (*MarkAllReadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllRead not implemented")
+}
+func (*UnimplementedNotificatorServer) UnreadCount(context.Context, *UnreadCountRequest) (*UnreadCountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnreadCount not implemented")
+}
+func (*UnimplementedNotificatorServer) ExportUser(context.Context, *ExportUserRequest) (*ExportUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUser not implemented")
+}
+func (*UnimplementedNotificatorServer) Subscribe(*SubscribeRequest, Notificator_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
 
 func RegisterNotificatorServer(s *grpc.Server, srv NotificatorServer) {
 	s.RegisterService(&_Notificator_serviceDesc, srv)
@@ -355,6 +3855,261 @@ func _Notificator_Send_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Notificator_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).GetStatus(ctx, req.(*GetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/CreateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/GetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/UpdateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/DeleteTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/ListTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/GetPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/UpdatePreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_ListForRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).ListForRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/ListForRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).ListForRecipient(ctx, req.(*ListForRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/MarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_MarkAllRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).MarkAllRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/MarkAllRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).MarkAllRead(ctx, req.(*MarkAllReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_UnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).UnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/UnreadCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).UnreadCount(ctx, req.(*UnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_ExportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificatorServer).ExportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Notificator/ExportUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificatorServer).ExportUser(ctx, req.(*ExportUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notificator_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificatorServer).Subscribe(m, &notificatorSubscribeServer{stream})
+}
+
+type Notificator_SubscribeServer interface {
+	Send(*InboxItem) error
+	grpc.ServerStream
+}
+
+type notificatorSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificatorSubscribeServer) Send(m *InboxItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Notificator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Notificator",
 	HandlerType: (*NotificatorServer)(nil),
@@ -363,7 +4118,65 @@ var _Notificator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _Notificator_Send_Handler,
 		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _Notificator_GetStatus_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _Notificator_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _Notificator_GetTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _Notificator_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _Notificator_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _Notificator_ListTemplates_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _Notificator_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _Notificator_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "ListForRecipient",
+			Handler:    _Notificator_ListForRecipient_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _Notificator_MarkRead_Handler,
+		},
+		{
+			MethodName: "MarkAllRead",
+			Handler:    _Notificator_MarkAllRead_Handler,
+		},
+		{
+			MethodName: "UnreadCount",
+			Handler:    _Notificator_UnreadCount_Handler,
+		},
+		{
+			MethodName: "ExportUser",
+			Handler:    _Notificator_ExportUser_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Notificator_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "notificator.proto",
 }
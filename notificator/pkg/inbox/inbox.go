@@ -0,0 +1,163 @@
+package inbox
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+)
+
+const (
+	defaultLimit int64 = 20
+	maxLimit     int64 = 100
+)
+
+// Inbox reads the notifications of a user, newest first. Suppressed
+// notifications were never meant to reach the user and are left out.
+type Inbox struct {
+	db *mongo.Collection
+}
+
+// New returns an Inbox on the notifications collection.
+func New(db *mongo.Collection) *Inbox {
+	return &Inbox{db: db}
+}
+
+// Index creates the (userID, createdAt) index the inbox queries use.
+func (i *Inbox) Index(ctx context.Context) error {
+	_, err := i.db.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "userID", Value: 1}, {Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	return err
+}
+
+// List returns a page of the notifications of userID and the cursor of the
+// next page, empty on the last one.
+func (i *Inbox) List(ctx context.Context, userID string, limit int64, cur string, unreadOnly bool) ([]model.Notification, string, error) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	filter := i.filter(userID, unreadOnly)
+	if cur != "" {
+		c, err := decodeCursor(cur)
+		if err != nil {
+			return nil, "", err
+		}
+		filter["$or"] = c.after()
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(limit + 1)
+	rows, err := i.db.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close(ctx)
+
+	items := []model.Notification{}
+	if err := rows.All(ctx, &items); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if int64(len(items)) > limit {
+		items = items[:limit]
+		next = encodeCursor(items[limit-1])
+	}
+	return items, next, nil
+}
+
+// MarkRead marks one notification of userID as read. Marking it again is a no-op.
+func (i *Inbox) MarkRead(ctx context.Context, userID, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+	res, err := i.db.UpdateOne(ctx,
+		bson.M{"_id": oid, "userID": userID},
+		bson.M{"$max": bson.M{"readAt": time.Now().UTC()}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// MarkAllRead marks every unread notification of userID as read and
+// returns how many there were.
+func (i *Inbox) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	res, err := i.db.UpdateMany(ctx,
+		i.filter(userID, true),
+		bson.M{"$set": bson.M{"readAt": time.Now().UTC()}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// UnreadCount returns the number of unread notifications of userID.
+func (i *Inbox) UnreadCount(ctx context.Context, userID string) (int64, error) {
+	return i.db.CountDocuments(ctx, i.filter(userID, true))
+}
+
+func (i *Inbox) filter(userID string, unreadOnly bool) bson.M {
+	filter := bson.M{
+		"userID": userID,
+		"status": bson.M{"$ne": model.StatusSuppressed},
+	}
+	if unreadOnly {
+		filter["readAt"] = bson.M{"$exists": false}
+	}
+	return filter
+}
+
+// cursor marks the last notification of a page.
+type cursor struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func encodeCursor(n model.Notification) string {
+	bt, err := json.Marshal(cursor{ID: n.ID, CreatedAt: n.CreatedAt})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(bt)
+}
+
+func decodeCursor(s string) (*cursor, error) {
+	bt, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	c := &cursor{}
+	if err := json.Unmarshal(bt, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// after returns the filter for the notifications older than c.
+func (c *cursor) after() []bson.M {
+	oid, _ := primitive.ObjectIDFromHex(c.ID)
+	return []bson.M{
+		{"createdAt": bson.M{"$lt": c.CreatedAt}},
+		{"createdAt": c.CreatedAt, "_id": bson.M{"$lt": oid}},
+	}
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// Delivery states of a notification
+const (
+	StatusQueued  = "queued"
+	StatusSending = "sending"
+	StatusSent    = "sent"
+	StatusDead    = "dead"
+	// StatusSuppressed is recorded instead of queueing when the user's
+	// preferences opt out of the notification.
+	StatusSuppressed = "suppressed"
+)
+
+// Notification model
+type Notification struct {
+	ID            string            `json:"id" bson:"_id,omitempty"`
+	UserID        string            `json:"user_id" bson:"userID,omitempty"`
+	To            string            `json:"to" bson:"to"`
+	Subject       string            `json:"subject" bson:"subject"`
+	Body          string            `json:"body" bson:"body"`
+	Channel       string            `json:"channel" bson:"channel"`
+	Template      string            `json:"template" bson:"template,omitempty"`
+	Locale        string            `json:"locale" bson:"locale,omitempty"`
+	Variables     map[string]string `json:"variables" bson:"-"`
+	Status        string            `json:"status" bson:"status"`
+	Attempts      int               `json:"attempts" bson:"attempts"`
+	LastError     string            `json:"last_error" bson:"lastError"`
+	Reason        string            `json:"reason" bson:"reason,omitempty"`
+	NextAttemptAt time.Time         `json:"next_attempt_at" bson:"nextAttemptAt"`
+	LockedUntil   time.Time         `json:"-" bson:"lockedUntil"`
+	CreatedAt     time.Time         `json:"created_at" bson:"createdAt"`
+	UpdatedAt     time.Time         `json:"updated_at" bson:"updatedAt"`
+	ReadAt        *time.Time        `json:"read_at,omitempty" bson:"readAt,omitempty"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// Preferences model, what a user wants to be notified about and how.
+// Events and channels missing from the maps are enabled.
+type Preferences struct {
+	UserID     string          `json:"user_id" bson:"_id"`
+	Events     map[string]bool `json:"events" bson:"events"`
+	Channels   map[string]bool `json:"channels" bson:"channels"`
+	QuietHours QuietHours      `json:"quiet_hours" bson:"quietHours"`
+	UpdatedAt  time.Time       `json:"updated_at" bson:"updatedAt"`
+}
+
+// QuietHours is a daily window, in HH:MM of Timezone, in which deliveries
+// are held back. Start after End spans midnight.
+type QuietHours struct {
+	Start    string `json:"start" bson:"start"`
+	End      string `json:"end" bson:"end"`
+	Timezone string `json:"timezone" bson:"timezone"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// Template model
+type Template struct {
+	ID        string    `json:"id" bson:"_id,omitempty"`
+	Name      string    `json:"name" bson:"name"`
+	Locale    string    `json:"locale" bson:"locale"`
+	Subject   string    `json:"subject" bson:"subject"`
+	Body      string    `json:"body" bson:"body"`
+	HTML      bool      `json:"html" bson:"html"`
+	CreatedAt time.Time `json:"created_at" bson:"createdAt"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updatedAt"`
+}
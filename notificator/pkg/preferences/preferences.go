@@ -0,0 +1,82 @@
+package preferences
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+)
+
+// Reasons a send is suppressed
+const (
+	ReasonEvent   = "event disabled by user"
+	ReasonChannel = "channel disabled by user"
+)
+
+// required events are security related and always delivered.
+var required = map[string]bool{
+	"verification-code": true,
+	"password-reset":    true,
+}
+
+// Store keeps one preferences document per user in mongo.
+type Store struct {
+	db *mongo.Collection
+}
+
+// New returns a Store on db.
+func New(db *mongo.Collection) *Store {
+	return &Store{db: db}
+}
+
+// Get returns the preferences of userID, everything enabled when the user
+// has not saved any.
+func (s *Store) Get(ctx context.Context, userID string) (model.Preferences, error) {
+	p := model.Preferences{}
+	err := s.db.FindOne(ctx, bson.M{"_id": userID}).Decode(&p)
+	if err == mongo.ErrNoDocuments {
+		return model.Preferences{UserID: userID, Events: map[string]bool{}, Channels: map[string]bool{}}, nil
+	}
+	return p, err
+}
+
+// Update replaces the preferences of p.UserID and returns the saved document.
+func (s *Store) Update(ctx context.Context, p model.Preferences) (model.Preferences, error) {
+	if p.UserID == "" {
+		return p, fmt.Errorf("user id is required")
+	}
+	if _, err := quiet(p.QuietHours).window(); err != nil {
+		return p, err
+	}
+	if p.Events == nil {
+		p.Events = map[string]bool{}
+	}
+	if p.Channels == nil {
+		p.Channels = map[string]bool{}
+	}
+	p.UpdatedAt = time.Now().UTC()
+
+	_, err := s.db.ReplaceOne(ctx, bson.M{"_id": p.UserID}, p, options.Replace().SetUpsert(true))
+	return p, err
+}
+
+// Check decides what to do with n for a user with preferences p at now. A
+// non-empty reason means n must not be delivered; otherwise it may be
+// delivered at the returned time, which is later than now in quiet hours.
+func Check(p model.Preferences, n model.Notification, now time.Time) (at time.Time, reason string) {
+	if required[n.Template] {
+		return now, ""
+	}
+	if enabled, ok := p.Events[n.Template]; ok && !enabled && n.Template != "" {
+		return now, ReasonEvent
+	}
+	if enabled, ok := p.Channels[n.Channel]; ok && !enabled {
+		return now, ReasonChannel
+	}
+	return quiet(p.QuietHours).next(now), ""
+}
@@ -0,0 +1,68 @@
+package preferences
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+)
+
+type window struct {
+	start, end time.Duration
+	loc        *time.Location
+}
+
+// quiet wraps the model to hang the time calculations on it.
+type quiet model.QuietHours
+
+func (q quiet) window() (*window, error) {
+	if q.Start == "" && q.End == "" {
+		return nil, nil
+	}
+	start, err := clock(q.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := clock(q.End)
+	if err != nil {
+		return nil, err
+	}
+	loc := time.UTC
+	if q.Timezone != "" {
+		if loc, err = time.LoadLocation(q.Timezone); err != nil {
+			return nil, err
+		}
+	}
+	return &window{start: start, end: end, loc: loc}, nil
+}
+
+// next returns now, or the end of the quiet hours when now falls in them.
+func (q quiet) next(now time.Time) time.Time {
+	w, err := q.window()
+	if err != nil || w == nil || w.start == w.end {
+		return now
+	}
+
+	local := now.In(w.loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, w.loc)
+	since := local.Sub(midnight)
+
+	switch {
+	case w.start < w.end && since >= w.start && since < w.end:
+		return midnight.Add(w.end)
+	case w.start > w.end && since >= w.start:
+		return midnight.AddDate(0, 0, 1).Add(w.end)
+	case w.start > w.end && since < w.end:
+		return midnight.Add(w.end)
+	}
+	return now
+}
+
+// clock parses "HH:MM" to the offset from midnight.
+func clock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quiet hours time %q, want HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -0,0 +1,283 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/channel"
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+)
+
+// Options tune the delivery workers.
+type Options struct {
+	// MaxAttempts is how many deliveries are tried before a
+	// notification is dead-lettered.
+	MaxAttempts int
+	// Backoff is the delay after the first failure, it doubles on every
+	// following failure up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Lease is how long a worker owns a claimed notification; after it a
+	// crashed worker's notification is picked up again.
+	Lease time.Duration
+	// Poll is how long an idle worker sleeps before looking for work.
+	Poll time.Duration
+}
+
+// Queue persists notifications in mongo and delivers them in the background.
+type Queue struct {
+	db       *mongo.Collection
+	dead     *mongo.Collection
+	channels map[string]channel.Channel
+	opts     Options
+}
+
+// New returns a Queue storing pending notifications in db and the ones
+// that ran out of attempts in dead.
+func New(db, dead *mongo.Collection, channels map[string]channel.Channel, opts Options) *Queue {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 5
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = 5 * time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 10 * time.Minute
+	}
+	if opts.Lease <= 0 {
+		opts.Lease = time.Minute
+	}
+	if opts.Poll <= 0 {
+		opts.Poll = time.Second
+	}
+	return &Queue{db: db, dead: dead, channels: channels, opts: opts}
+}
+
+// Enqueue stores n for delivery and returns its id without waiting for it.
+func (q *Queue) Enqueue(ctx context.Context, n model.Notification) (string, error) {
+	if _, ok := q.channels[n.Channel]; !ok {
+		return "", fmt.Errorf("channel %q is not configured", n.Channel)
+	}
+
+	// a later NextAttemptAt holds the notification back, e.g. quiet hours
+	now := time.Now().UTC()
+	next := now
+	if n.NextAttemptAt.After(now) {
+		next = n.NextAttemptAt.UTC()
+	}
+	values := bson.M{
+		"userID":        n.UserID,
+		"to":            n.To,
+		"subject":       n.Subject,
+		"body":          n.Body,
+		"channel":       n.Channel,
+		"template":      n.Template,
+		"status":        model.StatusQueued,
+		"attempts":      0,
+		"nextAttemptAt": next,
+		"createdAt":     now,
+		"updatedAt":     now,
+	}
+	return q.insert(ctx, values)
+}
+
+// Suppress records n as not sent because of reason, so GetStatus can
+// explain what happened to it.
+func (q *Queue) Suppress(ctx context.Context, n model.Notification, reason string) (string, error) {
+	now := time.Now().UTC()
+	values := bson.M{
+		"userID":    n.UserID,
+		"to":        n.To,
+		"subject":   n.Subject,
+		"body":      n.Body,
+		"channel":   n.Channel,
+		"template":  n.Template,
+		"status":    model.StatusSuppressed,
+		"reason":    reason,
+		"attempts":  0,
+		"createdAt": now,
+		"updatedAt": now,
+	}
+	return q.insert(ctx, values)
+}
+
+func (q *Queue) insert(ctx context.Context, values bson.M) (string, error) {
+	res, err := q.db.InsertOne(ctx, values)
+	if err != nil {
+		return "", err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("unexpected inserted id %v", res.InsertedID)
+	}
+	return oid.Hex(), nil
+}
+
+// Get returns the stored notification with the given id.
+func (q *Queue) Get(ctx context.Context, id string) (model.Notification, error) {
+	n := model.Notification{}
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return n, err
+	}
+	err = q.db.FindOne(ctx, bson.M{"_id": oid}).Decode(&n)
+	return n, err
+}
+
+// Run starts workers delivery goroutines and blocks until ctx is done.
+func (q *Queue) Run(ctx context.Context, workers int) {
+	if workers <= 0 {
+		workers = 1
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+func (q *Queue) work(ctx context.Context) {
+	for {
+		n, err := q.claim(ctx)
+		if err != nil {
+			if err != mongo.ErrNoDocuments {
+				log.Printf("failed to claim notification: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(q.opts.Poll):
+			}
+			continue
+		}
+		q.deliver(ctx, n)
+	}
+}
+
+// claim takes the oldest due notification, or one whose worker lease ran out.
+func (q *Queue) claim(ctx context.Context) (model.Notification, error) {
+	n := model.Notification{}
+	now := time.Now().UTC()
+	filter := bson.M{"$or": []bson.M{
+		{"status": model.StatusQueued, "nextAttemptAt": bson.M{"$lte": now}},
+		{"status": model.StatusSending, "lockedUntil": bson.M{"$lte": now}},
+	}}
+	update := bson.M{"$set": bson.M{
+		"status":      model.StatusSending,
+		"lockedUntil": now.Add(q.opts.Lease),
+		"updatedAt":   now,
+	}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).
+		SetReturnDocument(options.After)
+	err := q.db.FindOneAndUpdate(ctx, filter, update, opts).Decode(&n)
+	return n, err
+}
+
+func (q *Queue) deliver(ctx context.Context, n model.Notification) {
+	oid, err := primitive.ObjectIDFromHex(n.ID)
+	if err != nil {
+		log.Printf("invalid notification id %q: %v", n.ID, err)
+		return
+	}
+
+	dErr := fmt.Errorf("channel %q is not configured", n.Channel)
+	if ch, ok := q.channels[n.Channel]; ok {
+		dErr = ch.Deliver(ctx, channel.Message{To: n.To, Subject: n.Subject, Body: n.Body})
+	}
+
+	now := time.Now().UTC()
+	if dErr == nil {
+		_, err := q.db.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{
+			"$set":   bson.M{"status": model.StatusSent, "updatedAt": now, "sentAt": now},
+			"$inc":   bson.M{"attempts": 1},
+			"$unset": bson.M{"lockedUntil": ""},
+		})
+		if err != nil {
+			log.Printf("failed to mark notification %s as sent: %v", n.ID, err)
+		}
+		return
+	}
+
+	n.Attempts++
+	n.LastError = dErr.Error()
+	log.Printf("failed to deliver notification %s, attempt %d: %v", n.ID, n.Attempts, dErr)
+
+	if n.Attempts >= q.opts.MaxAttempts {
+		q.bury(ctx, oid, n, now)
+		return
+	}
+
+	_, err = q.db.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{
+		"$set": bson.M{
+			"status":        model.StatusQueued,
+			"attempts":      n.Attempts,
+			"lastError":     n.LastError,
+			"nextAttemptAt": now.Add(q.backoff(n.Attempts)),
+			"updatedAt":     now,
+		},
+		"$unset": bson.M{"lockedUntil": ""},
+	})
+	if err != nil {
+		log.Printf("failed to reschedule notification %s: %v", n.ID, err)
+	}
+}
+
+// bury moves a notification that ran out of attempts to the dead-letter collection.
+func (q *Queue) bury(ctx context.Context, oid primitive.ObjectID, n model.Notification, now time.Time) {
+	_, err := q.dead.InsertOne(ctx, bson.M{
+		"_id":       oid,
+		"to":        n.To,
+		"subject":   n.Subject,
+		"body":      n.Body,
+		"channel":   n.Channel,
+		"attempts":  n.Attempts,
+		"lastError": n.LastError,
+		"createdAt": n.CreatedAt,
+		"deadAt":    now,
+	})
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		log.Printf("failed to dead-letter notification %s: %v", n.ID, err)
+		return
+	}
+
+	_, err = q.db.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{
+		"$set": bson.M{
+			"status":    model.StatusDead,
+			"attempts":  n.Attempts,
+			"lastError": n.LastError,
+			"updatedAt": now,
+		},
+		"$unset": bson.M{"lockedUntil": ""},
+	})
+	if err != nil {
+		log.Printf("failed to mark notification %s as dead: %v", n.ID, err)
+	}
+}
+
+// backoff returns the exponential delay before the next attempt, with
+// some jitter so failed notifications do not retry in lockstep.
+func (q *Queue) backoff(attempts int) time.Duration {
+	d := q.opts.Backoff
+	for i := 1; i < attempts && d < q.opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > q.opts.MaxBackoff {
+		d = q.opts.MaxBackoff
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
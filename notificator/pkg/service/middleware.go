@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+
 	log "github.com/go-kit/kit/log"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
 )
 
 // Middleware describes a service middleware.
@@ -22,9 +25,93 @@ func LoggingMiddleware(logger log.Logger) Middleware {
 
 }
 
-func (l loggingMiddleware) Send(ctx context.Context, to string, body string) (id string, err error) {
+func (l loggingMiddleware) Send(ctx context.Context, n model.Notification) (id string, err error) {
+	defer func() {
+		l.logger.Log("method", "Send", "to", n.To, "channel", n.Channel, "subject", n.Subject, "id", id, "err", err)
+	}()
+	return l.next.Send(ctx, n)
+}
+func (l loggingMiddleware) GetStatus(ctx context.Context, id string) (notification model.Notification, err error) {
+	defer func() {
+		l.logger.Log("method", "GetStatus", "id", id, "notification", notification, "err", err)
+	}()
+	return l.next.GetStatus(ctx, id)
+}
+func (l loggingMiddleware) CreateTemplate(ctx context.Context, t model.Template) (id string, err error) {
+	defer func() {
+		l.logger.Log("method", "CreateTemplate", "t", t, "id", id, "err", err)
+	}()
+	return l.next.CreateTemplate(ctx, t)
+}
+func (l loggingMiddleware) GetTemplate(ctx context.Context, name string, locale string) (t model.Template, err error) {
+	defer func() {
+		l.logger.Log("method", "GetTemplate", "name", name, "locale", locale, "t", t, "err", err)
+	}()
+	return l.next.GetTemplate(ctx, name, locale)
+}
+func (l loggingMiddleware) UpdateTemplate(ctx context.Context, t model.Template) (id string, err error) {
+	defer func() {
+		l.logger.Log("method", "UpdateTemplate", "t", t, "id", id, "err", err)
+	}()
+	return l.next.UpdateTemplate(ctx, t)
+}
+func (l loggingMiddleware) DeleteTemplate(ctx context.Context, name string, locale string) (id string, err error) {
+	defer func() {
+		l.logger.Log("method", "DeleteTemplate", "name", name, "locale", locale, "id", id, "err", err)
+	}()
+	return l.next.DeleteTemplate(ctx, name, locale)
+}
+func (l loggingMiddleware) ListTemplates(ctx context.Context, name string) (items []model.Template, err error) {
+	defer func() {
+		l.logger.Log("method", "ListTemplates", "name", name, "items", items, "err", err)
+	}()
+	return l.next.ListTemplates(ctx, name)
+}
+func (l loggingMiddleware) GetPreferences(ctx context.Context, userID string) (p model.Preferences, err error) {
+	defer func() {
+		l.logger.Log("method", "GetPreferences", "userID", userID, "p", p, "err", err)
+	}()
+	return l.next.GetPreferences(ctx, userID)
+}
+func (l loggingMiddleware) UpdatePreferences(ctx context.Context, p model.Preferences) (saved model.Preferences, err error) {
+	defer func() {
+		l.logger.Log("method", "UpdatePreferences", "p", p, "saved", saved, "err", err)
+	}()
+	return l.next.UpdatePreferences(ctx, p)
+}
+func (l loggingMiddleware) ListForRecipient(ctx context.Context, userID string, limit int64, cursor string, unreadOnly bool) (items []model.Notification, next string, err error) {
+	defer func() {
+		l.logger.Log("method", "ListForRecipient", "userID", userID, "limit", limit, "cursor", cursor, "unreadOnly", unreadOnly, "items", items, "next", next, "err", err)
+	}()
+	return l.next.ListForRecipient(ctx, userID, limit, cursor, unreadOnly)
+}
+func (l loggingMiddleware) MarkRead(ctx context.Context, userID string, id string) (response string, err error) {
+	defer func() {
+		l.logger.Log("method", "MarkRead", "userID", userID, "id", id, "response", response, "err", err)
+	}()
+	return l.next.MarkRead(ctx, userID, id)
+}
+func (l loggingMiddleware) MarkAllRead(ctx context.Context, userID string) (count int64, err error) {
+	defer func() {
+		l.logger.Log("method", "MarkAllRead", "userID", userID, "count", count, "err", err)
+	}()
+	return l.next.MarkAllRead(ctx, userID)
+}
+func (l loggingMiddleware) UnreadCount(ctx context.Context, userID string) (count int64, err error) {
+	defer func() {
+		l.logger.Log("method", "UnreadCount", "userID", userID, "count", count, "err", err)
+	}()
+	return l.next.UnreadCount(ctx, userID)
+}
+func (l loggingMiddleware) Subscribe(ctx context.Context, token string) (events <-chan model.Notification, err error) {
+	defer func() {
+		l.logger.Log("method", "Subscribe", "err", err)
+	}()
+	return l.next.Subscribe(ctx, token)
+}
+func (l loggingMiddleware) ExportUser(ctx context.Context, userID string) (data []byte, err error) {
 	defer func() {
-		l.logger.Log("method", "Send", "to", to, "body", body, "id", id, "err", err)
+		l.logger.Log("method", "ExportUser", "userID", userID, "err", err)
 	}()
-	return l.next.Send(ctx, to, body)
+	return l.next.ExportUser(ctx, userID)
 }
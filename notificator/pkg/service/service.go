@@ -2,44 +2,104 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"log"
+	"strconv"
 	"time"
 
+	rd "github.com/go-redis/redis"
+	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
 	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
 
-	"github.com/emadghaffari/api-teacher/utils/random"
+	"github.com/emadghaffari/kit-blog/notificator/config"
+	"github.com/emadghaffari/kit-blog/notificator/pkg/broker"
+	"github.com/emadghaffari/kit-blog/notificator/pkg/channel"
+	"github.com/emadghaffari/kit-blog/notificator/pkg/inbox"
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+	"github.com/emadghaffari/kit-blog/notificator/pkg/preferences"
+	"github.com/emadghaffari/kit-blog/notificator/pkg/queue"
+	"github.com/emadghaffari/kit-blog/notificator/pkg/templates"
+	"github.com/emadghaffari/kit-blog/users/pkg/auth"
+	us "github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
 )
 
 // NotificatorService describes the service.
 type NotificatorService interface {
 	// Add your methods here
-	Send(ctx context.Context, to, body string) (id string, err error)
+	Send(ctx context.Context, n model.Notification) (id string, err error)
+	GetStatus(ctx context.Context, id string) (notification model.Notification, err error)
+	CreateTemplate(ctx context.Context, t model.Template) (id string, err error)
+	GetTemplate(ctx context.Context, name string, locale string) (t model.Template, err error)
+	UpdateTemplate(ctx context.Context, t model.Template) (id string, err error)
+	DeleteTemplate(ctx context.Context, name string, locale string) (id string, err error)
+	ListTemplates(ctx context.Context, name string) (items []model.Template, err error)
+	GetPreferences(ctx context.Context, userID string) (p model.Preferences, err error)
+	UpdatePreferences(ctx context.Context, p model.Preferences) (saved model.Preferences, err error)
+	ListForRecipient(ctx context.Context, userID string, limit int64, cursor string, unreadOnly bool) (items []model.Notification, next string, err error)
+	MarkRead(ctx context.Context, userID string, id string) (response string, err error)
+	MarkAllRead(ctx context.Context, userID string) (count int64, err error)
+	UnreadCount(ctx context.Context, userID string) (count int64, err error)
+	Subscribe(ctx context.Context, token string) (events <-chan model.Notification, err error)
+	ExportUser(ctx context.Context, userID string) (data []byte, err error)
 }
 
 type basicNotificatorService struct {
-	db *mongo.Collection
+	queue       *queue.Queue
+	templates   *templates.Store
+	preferences *preferences.Store
+	inbox       *inbox.Inbox
+	broker      broker.Broker
+	users       us.UsersClient
 }
 
-func (b *basicNotificatorService) Send(ctx context.Context, to string, body string) (id string, err error) {
-	values := bson.M{
-		"to":    to,
-		"body":  body,
-		"notif": random.Rand(10000, 999999),
+// Send queues n for delivery and returns its id right away; the queue
+// workers deliver it in the background.
+func (b *basicNotificatorService) Send(ctx context.Context, n model.Notification) (id string, err error) {
+	if n.Template != "" {
+		n.Subject, n.Body, err = b.templates.Render(context.Background(), n.Template, n.Locale, n.Variables)
+		if err != nil {
+			log.Printf("Error in render template %s: %v", n.Template, err)
+			return "Fail", err
+		}
+	}
+
+	if n.Channel == "" {
+		n.Channel, err = channel.Route(n.To)
+		if err != nil {
+			return "Fail", err
+		}
 	}
-	res, err := b.db.InsertOne(context.Background(), values)
 
+	// notifications for a user follow their preferences
+	if n.UserID != "" {
+		p, err := b.preferences.Get(context.Background(), n.UserID)
+		if err != nil {
+			log.Printf("Error in get preferences: %v", err)
+			return "Fail", err
+		}
+		var reason string
+		n.NextAttemptAt, reason = preferences.Check(p, n, time.Now())
+		if reason != "" {
+			return b.queue.Suppress(context.Background(), n, reason)
+		}
+	}
+
+	id, err = b.queue.Enqueue(context.Background(), n)
 	if err != nil {
-		log.Printf("Error in insert data to mongodb: %v", err)
+		log.Printf("Error in queue notification: %v", err)
 		return "Fail", err
 	}
 
-	if _, ok := res.InsertedID.(primitive.ObjectID); !ok {
-		log.Printf("Error in get oid from res")
-		return "Fail", err
+	// push it to the open subscriptions of the recipient
+	if n.UserID != "" {
+		n.ID, n.Status, n.CreatedAt = id, model.StatusQueued, time.Now().UTC()
+		if err := b.broker.Publish(context.Background(), n); err != nil {
+			log.Printf("Error in publish notification: %v", err)
+		}
 	}
 
 	if parent := opentracing.SpanFromContext(ctx); parent != nil {
@@ -48,11 +108,146 @@ func (b *basicNotificatorService) Send(ctx context.Context, to string, body stri
 			span := tracer.StartSpan("notification", opentracing.ChildOf(pctx))
 			defer span.Finish()
 
-			span.SetTag("sended notification to", to)
+			span.SetTag("sended notification to", n.To)
+			span.SetTag("channel", n.Channel)
+		}
+	}
+
+	return id, nil
+}
+
+// GetStatus returns the delivery state of a queued notification.
+func (b *basicNotificatorService) GetStatus(ctx context.Context, id string) (notification model.Notification, err error) {
+	return b.queue.Get(context.Background(), id)
+}
+
+// CreateTemplate stores a new locale of a template.
+func (b *basicNotificatorService) CreateTemplate(ctx context.Context, t model.Template) (id string, err error) {
+	id, err = b.templates.Create(context.Background(), t)
+	if err != nil {
+		return "Fail", err
+	}
+	return id, nil
+}
+
+// GetTemplate returns the template that Send would use for name and locale.
+func (b *basicNotificatorService) GetTemplate(ctx context.Context, name string, locale string) (t model.Template, err error) {
+	return b.templates.Find(context.Background(), name, locale)
+}
+
+// UpdateTemplate replaces the subject and body of an existing template locale.
+func (b *basicNotificatorService) UpdateTemplate(ctx context.Context, t model.Template) (id string, err error) {
+	id, err = b.templates.Update(context.Background(), t)
+	if err != nil {
+		return "Fail", err
+	}
+	return id, nil
+}
+
+// DeleteTemplate removes one locale of a template.
+func (b *basicNotificatorService) DeleteTemplate(ctx context.Context, name string, locale string) (id string, err error) {
+	id, err = b.templates.Delete(context.Background(), name, locale)
+	if err != nil {
+		return "Fail", err
+	}
+	return id, nil
+}
+
+// ListTemplates returns every locale of name, or every template when name is empty.
+func (b *basicNotificatorService) ListTemplates(ctx context.Context, name string) (items []model.Template, err error) {
+	return b.templates.List(context.Background(), name)
+}
+
+// GetPreferences returns the notification preferences of a user.
+func (b *basicNotificatorService) GetPreferences(ctx context.Context, userID string) (p model.Preferences, err error) {
+	if err := auth.Allow(ctx, userID, auth.RoleAdmin); err != nil {
+		return p, err
+	}
+	return b.preferences.Get(context.Background(), userID)
+}
+
+// UpdatePreferences replaces the notification preferences of p.UserID.
+func (b *basicNotificatorService) UpdatePreferences(ctx context.Context, p model.Preferences) (saved model.Preferences, err error) {
+	if err := auth.Allow(ctx, p.UserID, auth.RoleAdmin); err != nil {
+		return saved, err
+	}
+	return b.preferences.Update(context.Background(), p)
+}
+
+// ListForRecipient returns a page of the in-app inbox of a user, newest first.
+func (b *basicNotificatorService) ListForRecipient(ctx context.Context, userID string, limit int64, cursor string, unreadOnly bool) (items []model.Notification, next string, err error) {
+	if err := auth.Allow(ctx, userID, auth.RoleAdmin); err != nil {
+		return nil, "", err
+	}
+	return b.inbox.List(context.Background(), userID, limit, cursor, unreadOnly)
+}
+
+// MarkRead marks one notification of the user as read.
+func (b *basicNotificatorService) MarkRead(ctx context.Context, userID string, id string) (response string, err error) {
+	if err := auth.Allow(ctx, userID, auth.RoleAdmin); err != nil {
+		return "Fail", err
+	}
+	if err := b.inbox.MarkRead(context.Background(), userID, id); err != nil {
+		return "Fail", err
+	}
+	return id, nil
+}
+
+// MarkAllRead marks every notification of the user as read.
+func (b *basicNotificatorService) MarkAllRead(ctx context.Context, userID string) (count int64, err error) {
+	if err := auth.Allow(ctx, userID, auth.RoleAdmin); err != nil {
+		return 0, err
+	}
+	return b.inbox.MarkAllRead(context.Background(), userID)
+}
+
+// UnreadCount returns the number of unread notifications of the user.
+func (b *basicNotificatorService) UnreadCount(ctx context.Context, userID string) (count int64, err error) {
+	if err := auth.Allow(ctx, userID, auth.RoleAdmin); err != nil {
+		return 0, err
+	}
+	return b.inbox.UnreadCount(context.Background(), userID)
+}
+
+// ExportUser returns the notifications and preferences of the user as JSON,
+// for the data export of the users service.
+func (b *basicNotificatorService) ExportUser(ctx context.Context, userID string) (data []byte, err error) {
+	if err := auth.Allow(ctx, userID, auth.RoleAdmin, auth.RoleService); err != nil {
+		return nil, err
+	}
+
+	export := struct {
+		Notifications []model.Notification `json:"notifications"`
+		Preferences   model.Preferences    `json:"preferences"`
+	}{Notifications: []model.Notification{}}
+
+	cursor := ""
+	for {
+		items, next, err := b.inbox.List(context.Background(), userID, 100, cursor, false)
+		if err != nil {
+			return nil, err
+		}
+		export.Notifications = append(export.Notifications, items...)
+		if next == "" {
+			break
 		}
+		cursor = next
 	}
 
-	return "SUCCESS", err
+	if export.Preferences, err = b.preferences.Get(context.Background(), userID); err != nil {
+		return nil, err
+	}
+	return json.Marshal(export)
+}
+
+// Subscribe authenticates token with the users service and returns the new
+// notifications of its user until ctx is done.
+func (b *basicNotificatorService) Subscribe(ctx context.Context, token string) (events <-chan model.Notification, err error) {
+	user, err := b.users.Authenticate(ctx, &us.AuthenticateRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	return b.broker.Subscribe(ctx, user.Id)
 }
 
 // NewBasicNotificatorService returns a naive, stateless implementation of NotificatorService.
@@ -62,8 +257,34 @@ func NewBasicNotificatorService() NotificatorService {
 	if err != nil {
 		return new(basicNotificatorService)
 	}
+
+	conn, err := initUsers()
+	if err != nil {
+		return new(basicNotificatorService)
+	}
+
+	q := queue.New(col, col.Database().Collection("dead-letters"), initChannels(), queue.Options{
+		MaxAttempts: config.Confs.Queue.MaxAttempts,
+	})
+	go q.Run(context.Background(), config.Confs.Queue.Workers)
+
+	tpl := templates.New(col.Database().Collection("templates"), config.Confs.Templates.DefaultLocale)
+	if err := tpl.Seed(context.Background()); err != nil {
+		log.Printf("Error in seed templates: %v", err)
+	}
+
+	ib := inbox.New(col)
+	if err := ib.Index(context.Background()); err != nil {
+		log.Printf("Error in create inbox index: %v", err)
+	}
+
 	return &basicNotificatorService{
-		db: col,
+		queue:       q,
+		templates:   tpl,
+		preferences: preferences.New(col.Database().Collection("preferences")),
+		inbox:       ib,
+		broker:      initBroker(),
+		users:       us.NewUsersClient(conn),
 	}
 }
 
@@ -90,8 +311,64 @@ func initMongoDB() (*mongo.Collection, error) {
 		return nil, err
 	}
 
-	users := client.Database("kit-notification").Collection("notification")
+	notifs := client.Database("kit-notification").Collection("notification")
+
+	// workers look for due notifications
+	_, err = notifs.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "nextAttemptAt", Value: 1}},
+	})
+	if err != nil {
+		log.Printf(err.Error())
+		return nil, err
+	}
+
+	return notifs, nil
+
+}
+
+// initChannels builds the delivery channels that have a configuration.
+func initChannels() map[string]channel.Channel {
+	channels := map[string]channel.Channel{}
+	if config.Confs.SMTP.Addr != "" {
+		channels[channel.Email] = channel.NewEmail(
+			config.Confs.SMTP.Addr,
+			config.Confs.SMTP.From,
+			config.Confs.SMTP.Username,
+			config.Confs.SMTP.Password,
+		)
+	}
+	if config.Confs.SMS.URL != "" {
+		channels[channel.SMS] = channel.NewSMS(channel.NewHTTPProvider(
+			config.Confs.SMS.URL,
+			config.Confs.SMS.APIKey,
+			config.Confs.SMS.From,
+		))
+	}
+	return channels
+}
 
-	return users, nil
+func initUsers() (*grpc.ClientConn, error) {
+	tracer := opentracing.GlobalTracer()
+	conn, err := grpc.Dial(config.Confs.Users.Host,
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(otgrpc.OpenTracingClientInterceptor(tracer, otgrpc.LogPayloads())))
+	if err != nil {
+		log.Printf("unable to connect to users service, %s", err.Error())
+		return nil, err
+	}
+	return conn, nil
+}
 
+// initBroker picks the subscription broker, redis when several instances
+// share the subscribers.
+func initBroker() broker.Broker {
+	if config.Confs.Broker.Kind != "redis" {
+		return broker.NewMemory()
+	}
+	db, err := strconv.Atoi(config.Confs.Redis.DB)
+	if err != nil {
+		log.Printf("Error in redis db %q: %v", config.Confs.Redis.DB, err)
+	}
+	client := rd.NewClient(&rd.Options{Addr: config.Confs.Redis.Host, DB: db})
+	return broker.NewRedis(context.Background(), client)
 }
@@ -0,0 +1,264 @@
+package templates
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/emadghaffari/kit-blog/notificator/pkg/model"
+)
+
+// Names of the templates the other services rely on.
+const (
+	Welcome       = "welcome"
+	LoginAlert    = "login-alert"
+	PasswordReset = "password-reset"
+	NewComment    = "new-comment"
+	Verification  = "verification-code"
+	CommentDigest = "comment-digest"
+)
+
+// ErrNotFound is returned when a template has no version for the locale
+// nor for the default locale.
+var ErrNotFound = errors.New("template not found")
+
+// defaults are seeded for the default locale on startup, so the other
+// services can send them before anyone manages templates.
+var defaults = []model.Template{
+	{Name: Welcome, Subject: "Welcome {{.username}}", Body: "Hi {{.username}}, welcome to the blog."},
+	{Name: LoginAlert, Subject: "New login", Body: "Hi {{.username}}, there was a new login to your account."},
+	{Name: PasswordReset, Subject: "Reset your password", Body: "Hi {{.username}}, use {{.token}} to reset your password. It expires in {{.expires}}."},
+	{Name: Verification, Subject: "Your verification code", Body: "Hi {{.username}}, your verification code is {{.code}}. It expires in {{.expires}}."},
+	{Name: NewComment, Subject: "New comment", Body: "Hi {{.username}}, {{.commenter}} commented on {{.post}}: {{.comment}}"},
+	{Name: CommentDigest, Subject: "{{.count}} new comments", Body: "Hi {{.username}}, there are {{.count}} new comments on your posts and comments."},
+}
+
+// Store keeps templates per name and locale in mongo.
+type Store struct {
+	db            *mongo.Collection
+	defaultLocale string
+}
+
+// New returns a Store that falls back to defaultLocale.
+func New(db *mongo.Collection, defaultLocale string) *Store {
+	if defaultLocale == "" {
+		defaultLocale = "en"
+	}
+	return &Store{db: db, defaultLocale: defaultLocale}
+}
+
+// Seed creates the unique (name, locale) index and the default templates
+// that are not there yet.
+func (s *Store) Seed(ctx context.Context) error {
+	_, err := s.db.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}, {Key: "locale", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, t := range defaults {
+		_, err := s.db.UpdateOne(ctx,
+			bson.M{"name": t.Name, "locale": s.defaultLocale},
+			bson.M{"$setOnInsert": bson.M{
+				"subject":   t.Subject,
+				"body":      t.Body,
+				"html":      t.HTML,
+				"createdAt": now,
+				"updatedAt": now,
+			}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Render executes the template for the locale, falling back to the
+// language without region and then to the default locale.
+func (s *Store) Render(ctx context.Context, name, locale string, vars map[string]string) (subject, body string, err error) {
+	t, err := s.Find(ctx, name, locale)
+	if err != nil {
+		return "", "", err
+	}
+
+	subject, err = renderText(t.Name+".subject", t.Subject, vars)
+	if err != nil {
+		return "", "", err
+	}
+	if t.HTML {
+		body, err = renderHTML(t.Name+".body", t.Body, vars)
+	} else {
+		body, err = renderText(t.Name+".body", t.Body, vars)
+	}
+	return subject, body, err
+}
+
+// Find returns the best template for the locale, with the same fallback as Render.
+func (s *Store) Find(ctx context.Context, name, locale string) (model.Template, error) {
+	for _, l := range s.candidates(locale) {
+		t, err := s.Get(ctx, name, l)
+		if err == nil {
+			return t, nil
+		}
+		if err != ErrNotFound {
+			return t, err
+		}
+	}
+	return model.Template{}, ErrNotFound
+}
+
+// Get returns the template for exactly this name and locale.
+func (s *Store) Get(ctx context.Context, name, locale string) (model.Template, error) {
+	t := model.Template{}
+	err := s.db.FindOne(ctx, bson.M{"name": name, "locale": locale}).Decode(&t)
+	if err == mongo.ErrNoDocuments {
+		return t, ErrNotFound
+	}
+	return t, err
+}
+
+// List returns every locale of the named template, or all templates when name is empty.
+func (s *Store) List(ctx context.Context, name string) ([]model.Template, error) {
+	filter := bson.M{}
+	if name != "" {
+		filter["name"] = name
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "locale", Value: 1}})
+	cur, err := s.db.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	items := []model.Template{}
+	if err := cur.All(ctx, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// Create stores a new template version after checking that it parses.
+func (s *Store) Create(ctx context.Context, t model.Template) (string, error) {
+	if err := s.validate(&t); err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	res, err := s.db.InsertOne(ctx, bson.M{
+		"name":      t.Name,
+		"locale":    t.Locale,
+		"subject":   t.Subject,
+		"body":      t.Body,
+		"html":      t.HTML,
+		"createdAt": now,
+		"updatedAt": now,
+	})
+	if err != nil {
+		return "", err
+	}
+	oid, _ := res.InsertedID.(primitive.ObjectID)
+	return oid.Hex(), nil
+}
+
+// Update replaces the subject and body of an existing template version.
+func (s *Store) Update(ctx context.Context, t model.Template) (string, error) {
+	if err := s.validate(&t); err != nil {
+		return "", err
+	}
+	cur := model.Template{}
+	err := s.db.FindOneAndUpdate(ctx,
+		bson.M{"name": t.Name, "locale": t.Locale},
+		bson.M{"$set": bson.M{
+			"subject":   t.Subject,
+			"body":      t.Body,
+			"html":      t.HTML,
+			"updatedAt": time.Now().UTC(),
+		}},
+	).Decode(&cur)
+	if err == mongo.ErrNoDocuments {
+		return "", ErrNotFound
+	}
+	return cur.ID, err
+}
+
+// Delete removes one locale of a template.
+func (s *Store) Delete(ctx context.Context, name, locale string) (string, error) {
+	cur := model.Template{}
+	err := s.db.FindOneAndDelete(ctx, bson.M{"name": name, "locale": locale}).Decode(&cur)
+	if err == mongo.ErrNoDocuments {
+		return "", ErrNotFound
+	}
+	return cur.ID, err
+}
+
+func (s *Store) validate(t *model.Template) error {
+	if t.Name == "" {
+		return errors.New("template name is required")
+	}
+	if t.Locale == "" {
+		t.Locale = s.defaultLocale
+	}
+	if _, err := texttemplate.New("subject").Parse(t.Subject); err != nil {
+		return err
+	}
+	if t.HTML {
+		_, err := htmltemplate.New("body").Parse(t.Body)
+		return err
+	}
+	_, err := texttemplate.New("body").Parse(t.Body)
+	return err
+}
+
+// candidates lists the locales to try, most specific first: "fa-IR", "fa", default.
+func (s *Store) candidates(locale string) []string {
+	out := []string{}
+	if locale != "" {
+		out = append(out, locale)
+		if i := strings.IndexAny(locale, "-_"); i > 0 {
+			out = append(out, locale[:i])
+		}
+	}
+	for _, l := range out {
+		if l == s.defaultLocale {
+			return out
+		}
+	}
+	return append(out, s.defaultLocale)
+}
+
+func renderText(name, src string, vars map[string]string) (string, error) {
+	t, err := texttemplate.New(name).Option("missingkey=zero").Parse(src)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, vars); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func renderHTML(name, src string, vars map[string]string) (string, error) {
+	t, err := htmltemplate.New(name).Option("missingkey=zero").Parse(src)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, vars); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
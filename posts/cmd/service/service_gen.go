@@ -20,10 +20,16 @@ func createService(endpoints endpoint.Endpoints) (g *group.Group) {
 }
 func defaultGRPCOptions(logger log.Logger, tracer opentracinggo.Tracer) map[string][]grpc.ServerOption {
 	options := map[string][]grpc.ServerOption{
-		"Delete": {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Delete", logger))},
-		"List":   {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "List", logger))},
-		"Store":  {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Store", logger))},
-		"Update": {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Update", logger))},
+		"Delete":       {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Delete", logger))},
+		"ExportUser":   {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "ExportUser", logger))},
+		"Feed":         {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Feed", logger))},
+		"Get":          {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Get", logger))},
+		"List":         {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "List", logger))},
+		"ListByAuthor": {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "ListByAuthor", logger))},
+		"React":        {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "React", logger))},
+		"Store":        {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Store", logger))},
+		"Unreact":      {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Unreact", logger))},
+		"Update":       {grpc.ServerErrorLogger(logger), grpc.ServerBefore(opentracing.GRPCToContext(tracer, "Update", logger))},
 	}
 	return options
 }
@@ -32,12 +38,18 @@ func addDefaultEndpointMiddleware(logger log.Logger, duration *prometheus.Summar
 	mw["Update"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Update")), endpoint.InstrumentingMiddleware(duration.With("method", "Update"))}
 	mw["List"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "List")), endpoint.InstrumentingMiddleware(duration.With("method", "List"))}
 	mw["Delete"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Delete")), endpoint.InstrumentingMiddleware(duration.With("method", "Delete"))}
+	mw["React"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "React")), endpoint.InstrumentingMiddleware(duration.With("method", "React"))}
+	mw["Unreact"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Unreact")), endpoint.InstrumentingMiddleware(duration.With("method", "Unreact"))}
+	mw["Get"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Get")), endpoint.InstrumentingMiddleware(duration.With("method", "Get"))}
+	mw["ExportUser"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "ExportUser")), endpoint.InstrumentingMiddleware(duration.With("method", "ExportUser"))}
+	mw["ListByAuthor"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "ListByAuthor")), endpoint.InstrumentingMiddleware(duration.With("method", "ListByAuthor"))}
+	mw["Feed"] = []endpoint1.Middleware{endpoint.LoggingMiddleware(log.With(logger, "method", "Feed")), endpoint.InstrumentingMiddleware(duration.With("method", "Feed"))}
 }
 func addDefaultServiceMiddleware(logger log.Logger, mw []service.Middleware) []service.Middleware {
 	return append(mw, service.LoggingMiddleware(logger))
 }
 func addEndpointMiddlewareToAllMethods(mw map[string][]endpoint1.Middleware, m endpoint1.Middleware) {
-	methods := []string{"Store", "Update", "List", "Delete"}
+	methods := []string{"Store", "Update", "List", "Delete", "React", "Unreact", "Get", "ExportUser", "ListByAuthor", "Feed"}
 	for _, v := range methods {
 		mw[v] = append(mw[v], m)
 	}
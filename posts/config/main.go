@@ -15,6 +15,11 @@ type (
 			HTTPAddr   string
 			GrpcAddr   string
 			ThriftAddr string
+			// JWKS, Issuer and Audience check access tokens without a call
+			// to users, which is asked about API keys only
+			JWKS     string
+			Issuer   string
+			Audience string
 		}
 		Posts struct {
 			Host       string
@@ -24,6 +29,16 @@ type (
 			GrpcAddr   string
 			ThriftAddr string
 		}
+		DeletedUsers struct {
+			// Policy is events.PolicyAnonymize or events.PolicyDelete
+			Policy string
+		}
+		Service struct {
+			Path string
+			// Key is shared by the blog services to call the methods
+			// users may not, like ExportUser
+			Key string
+		}
 		Vault struct {
 			Address string
 			Token   string
@@ -122,6 +122,186 @@ func (r DeleteResponse) Failed() error {
 	return r.Err
 }
 
+// ReactRequest collects the request parameters for the React method.
+type ReactRequest struct {
+	UserID string `json:"user_id"`
+	PostID string `json:"post_id"`
+}
+
+// ReactResponse collects the response parameters for the React method.
+type ReactResponse struct {
+	Likes int64 `json:"likes"`
+	Err   error `json:"err"`
+}
+
+// MakeReactEndpoint returns an endpoint that invokes React on the service.
+func MakeReactEndpoint(s service.PostsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ReactRequest)
+		likes, err := s.React(ctx, req.UserID, req.PostID)
+		return ReactResponse{
+			Likes: likes,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ReactResponse) Failed() error {
+	return r.Err
+}
+
+// UnreactRequest collects the request parameters for the Unreact method.
+type UnreactRequest struct {
+	UserID string `json:"user_id"`
+	PostID string `json:"post_id"`
+}
+
+// UnreactResponse collects the response parameters for the Unreact method.
+type UnreactResponse struct {
+	Likes int64 `json:"likes"`
+	Err   error `json:"err"`
+}
+
+// MakeUnreactEndpoint returns an endpoint that invokes Unreact on the service.
+func MakeUnreactEndpoint(s service.PostsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UnreactRequest)
+		likes, err := s.Unreact(ctx, req.UserID, req.PostID)
+		return UnreactResponse{
+			Likes: likes,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r UnreactResponse) Failed() error {
+	return r.Err
+}
+
+// GetRequest collects the request parameters for the Get method.
+type GetRequest struct {
+	Id string `json:"id"`
+}
+
+// GetResponse collects the response parameters for the Get method.
+type GetResponse struct {
+	Post *pb.Post `json:"post"`
+	Err  error    `json:"err"`
+}
+
+// MakeGetEndpoint returns an endpoint that invokes Get on the service.
+func MakeGetEndpoint(s service.PostsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetRequest)
+		post, err := s.Get(ctx, req.Id)
+		return GetResponse{
+			Post: post,
+			Err:  err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r GetResponse) Failed() error {
+	return r.Err
+}
+
+// ExportUserRequest collects the request parameters for the ExportUser method.
+type ExportUserRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// ExportUserResponse collects the response parameters for the ExportUser method.
+type ExportUserResponse struct {
+	Data []byte `json:"data"`
+	Err  error  `json:"err"`
+}
+
+// MakeExportUserEndpoint returns an endpoint that invokes ExportUser on the service.
+func MakeExportUserEndpoint(s service.PostsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ExportUserRequest)
+		data, err := s.ExportUser(ctx, req.UserID)
+		return ExportUserResponse{
+			Data: data,
+			Err:  err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ExportUserResponse) Failed() error {
+	return r.Err
+}
+
+// ListByAuthorRequest collects the request parameters for the ListByAuthor method.
+type ListByAuthorRequest struct {
+	UserID string `json:"user_id"`
+	Limit  int64  `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+// ListByAuthorResponse collects the response parameters for the ListByAuthor method.
+type ListByAuthorResponse struct {
+	Posts []*pb.Post `json:"posts"`
+	Total int64      `json:"total"`
+	Next  string     `json:"next"`
+	Err   error      `json:"err"`
+}
+
+// MakeListByAuthorEndpoint returns an endpoint that invokes ListByAuthor on the service.
+func MakeListByAuthorEndpoint(s service.PostsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListByAuthorRequest)
+		posts, total, next, err := s.ListByAuthor(ctx, req.UserID, req.Limit, req.Cursor)
+		return ListByAuthorResponse{
+			Posts: posts,
+			Total: total,
+			Next:  next,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ListByAuthorResponse) Failed() error {
+	return r.Err
+}
+
+// FeedRequest collects the request parameters for the Feed method.
+type FeedRequest struct {
+	UserID string `json:"user_id"`
+	Limit  int64  `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+// FeedResponse collects the response parameters for the Feed method.
+type FeedResponse struct {
+	Posts []*pb.Post `json:"posts"`
+	Next  string     `json:"next"`
+	Err   error      `json:"err"`
+}
+
+// MakeFeedEndpoint returns an endpoint that invokes Feed on the service.
+func MakeFeedEndpoint(s service.PostsService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FeedRequest)
+		posts, next, err := s.Feed(ctx, req.UserID, req.Limit, req.Cursor)
+		return FeedResponse{
+			Posts: posts,
+			Next:  next,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r FeedResponse) Failed() error {
+	return r.Err
+}
+
 // Failure is an interface that should be implemented by response types.
 // Response encoders can check if responses are Failer, and if so they've
 // failed, and if so encode them using a separate write path based on the error.
@@ -168,3 +348,63 @@ func (e Endpoints) Delete(ctx context.Context, post model.Post) (response string
 	}
 	return response0.(DeleteResponse).Response, response0.(DeleteResponse).Err
 }
+
+// React implements Service. Primarily useful in a client.
+func (e Endpoints) React(ctx context.Context, userID string, postID string) (likes int64, err error) {
+	request := ReactRequest{UserID: userID, PostID: postID}
+	response, err := e.ReactEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ReactResponse).Likes, response.(ReactResponse).Err
+}
+
+// Unreact implements Service. Primarily useful in a client.
+func (e Endpoints) Unreact(ctx context.Context, userID string, postID string) (likes int64, err error) {
+	request := UnreactRequest{UserID: userID, PostID: postID}
+	response, err := e.UnreactEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(UnreactResponse).Likes, response.(UnreactResponse).Err
+}
+
+// Get implements Service. Primarily useful in a client.
+func (e Endpoints) Get(ctx context.Context, id string) (post *pb.Post, err error) {
+	request := GetRequest{Id: id}
+	response, err := e.GetEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(GetResponse).Post, response.(GetResponse).Err
+}
+
+// ExportUser implements Service. Primarily useful in a client.
+func (e Endpoints) ExportUser(ctx context.Context, userID string) (data []byte, err error) {
+	request := ExportUserRequest{UserID: userID}
+	response, err := e.ExportUserEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ExportUserResponse).Data, response.(ExportUserResponse).Err
+}
+
+// ListByAuthor implements Service. Primarily useful in a client.
+func (e Endpoints) ListByAuthor(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, total int64, next string, err error) {
+	request := ListByAuthorRequest{UserID: userID, Limit: limit, Cursor: cursor}
+	response, err := e.ListByAuthorEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ListByAuthorResponse).Posts, response.(ListByAuthorResponse).Total, response.(ListByAuthorResponse).Next, response.(ListByAuthorResponse).Err
+}
+
+// Feed implements Service. Primarily useful in a client.
+func (e Endpoints) Feed(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, next string, err error) {
+	request := FeedRequest{UserID: userID, Limit: limit, Cursor: cursor}
+	response, err := e.FeedEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(FeedResponse).Posts, response.(FeedResponse).Next, response.(FeedResponse).Err
+}
@@ -10,20 +10,32 @@ import (
 // meant to be used as a helper struct, to collect all of the endpoints into a
 // single parameter.
 type Endpoints struct {
-	StoreEndpoint  endpoint.Endpoint
-	UpdateEndpoint endpoint.Endpoint
-	ListEndpoint   endpoint.Endpoint
-	DeleteEndpoint endpoint.Endpoint
+	StoreEndpoint        endpoint.Endpoint
+	UpdateEndpoint       endpoint.Endpoint
+	ListEndpoint         endpoint.Endpoint
+	DeleteEndpoint       endpoint.Endpoint
+	ReactEndpoint        endpoint.Endpoint
+	UnreactEndpoint      endpoint.Endpoint
+	GetEndpoint          endpoint.Endpoint
+	ExportUserEndpoint   endpoint.Endpoint
+	ListByAuthorEndpoint endpoint.Endpoint
+	FeedEndpoint         endpoint.Endpoint
 }
 
 // New returns a Endpoints struct that wraps the provided service, and wires in all of the
 // expected endpoint middlewares
 func New(s service.PostsService, mdw map[string][]endpoint.Middleware) Endpoints {
 	eps := Endpoints{
-		DeleteEndpoint: MakeDeleteEndpoint(s),
-		ListEndpoint:   MakeListEndpoint(s),
-		StoreEndpoint:  MakeStoreEndpoint(s),
-		UpdateEndpoint: MakeUpdateEndpoint(s),
+		DeleteEndpoint:       MakeDeleteEndpoint(s),
+		ExportUserEndpoint:   MakeExportUserEndpoint(s),
+		FeedEndpoint:         MakeFeedEndpoint(s),
+		GetEndpoint:          MakeGetEndpoint(s),
+		ListByAuthorEndpoint: MakeListByAuthorEndpoint(s),
+		ListEndpoint:         MakeListEndpoint(s),
+		ReactEndpoint:        MakeReactEndpoint(s),
+		StoreEndpoint:        MakeStoreEndpoint(s),
+		UnreactEndpoint:      MakeUnreactEndpoint(s),
+		UpdateEndpoint:       MakeUpdateEndpoint(s),
 	}
 	for _, m := range mdw["Store"] {
 		eps.StoreEndpoint = m(eps.StoreEndpoint)
@@ -37,5 +49,23 @@ func New(s service.PostsService, mdw map[string][]endpoint.Middleware) Endpoints
 	for _, m := range mdw["Delete"] {
 		eps.DeleteEndpoint = m(eps.DeleteEndpoint)
 	}
+	for _, m := range mdw["React"] {
+		eps.ReactEndpoint = m(eps.ReactEndpoint)
+	}
+	for _, m := range mdw["Unreact"] {
+		eps.UnreactEndpoint = m(eps.UnreactEndpoint)
+	}
+	for _, m := range mdw["Get"] {
+		eps.GetEndpoint = m(eps.GetEndpoint)
+	}
+	for _, m := range mdw["ExportUser"] {
+		eps.ExportUserEndpoint = m(eps.ExportUserEndpoint)
+	}
+	for _, m := range mdw["ListByAuthor"] {
+		eps.ListByAuthorEndpoint = m(eps.ListByAuthorEndpoint)
+	}
+	for _, m := range mdw["Feed"] {
+		eps.FeedEndpoint = m(eps.FeedEndpoint)
+	}
 	return eps
 }
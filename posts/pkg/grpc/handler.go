@@ -23,6 +23,7 @@ func decodeStoreRequest(_ context.Context, r interface{}) (interface{}, error) {
 	return endpoint.StoreRequest{
 		Post: model.Post{
 			Token:       &req.Post.Token,
+			UserID:      req.Post.UserID,
 			Title:       req.Post.Title,
 			Body:        req.Post.Body,
 			Slug:        req.Post.Slug,
@@ -69,6 +70,7 @@ func decodeUpdateRequest(_ context.Context, r interface{}) (interface{}, error)
 		Post: model.Post{
 			ID:          req.Post.Id,
 			Token:       &req.Post.Token,
+			UserID:      req.Post.UserID,
 			Title:       req.Post.Title,
 			Body:        req.Post.Body,
 			Slug:        req.Post.Slug,
@@ -114,6 +116,7 @@ func decodeListRequest(_ context.Context, r interface{}) (interface{}, error) {
 	return endpoint.ListRequest{
 		Post: model.Post{
 			Token:       &req.Post.Token,
+			UserID:      req.Post.UserID,
 			Title:       req.Post.Title,
 			Body:        req.Post.Body,
 			Slug:        req.Post.Slug,
@@ -158,6 +161,7 @@ func decodeDeleteRequest(_ context.Context, r interface{}) (interface{}, error)
 		Post: model.Post{
 			ID:          req.Post.Id,
 			Token:       &req.Post.Token,
+			UserID:      req.Post.UserID,
 			Title:       req.Post.Title,
 			Body:        req.Post.Body,
 			Slug:        req.Post.Slug,
@@ -189,3 +193,162 @@ func (g *grpcServer) Delete(ctx context1.Context, req *pb.DeleteRequest) (*pb.De
 	}
 	return rep.(*pb.DeleteReply), nil
 }
+
+// makeReactHandler creates the handler logic
+func makeReactHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ReactEndpoint, decodeReactRequest, encodeReactResponse, options...)
+}
+
+// decodeReactResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain React request.
+func decodeReactRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ReactRequest)
+	return endpoint.ReactRequest{UserID: req.UserID, PostID: req.Id}, nil
+}
+
+// encodeReactResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeReactResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ReactResponse)
+	if resp.Err != nil {
+		return &pb.ReactReply{Likes: 0, Status: pb.ReactReply_Fail}, resp.Err
+	}
+	return &pb.ReactReply{Likes: resp.Likes, Status: pb.ReactReply_Success}, nil
+}
+func (g *grpcServer) React(ctx context1.Context, req *pb.ReactRequest) (*pb.ReactReply, error) {
+	_, rep, err := g.react.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ReactReply), nil
+}
+
+// makeUnreactHandler creates the handler logic
+func makeUnreactHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.UnreactEndpoint, decodeUnreactRequest, encodeUnreactResponse, options...)
+}
+
+// decodeUnreactResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain Unreact request.
+func decodeUnreactRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.UnreactRequest)
+	return endpoint.UnreactRequest{UserID: req.UserID, PostID: req.Id}, nil
+}
+
+// encodeUnreactResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeUnreactResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.UnreactResponse)
+	if resp.Err != nil {
+		return &pb.UnreactReply{Likes: 0, Status: pb.UnreactReply_Fail}, resp.Err
+	}
+	return &pb.UnreactReply{Likes: resp.Likes, Status: pb.UnreactReply_Success}, nil
+}
+func (g *grpcServer) Unreact(ctx context1.Context, req *pb.UnreactRequest) (*pb.UnreactReply, error) {
+	_, rep, err := g.unreact.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UnreactReply), nil
+}
+
+// makeGetHandler creates the handler logic
+func makeGetHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.GetEndpoint, decodeGetRequest, encodeGetResponse, options...)
+}
+
+// decodeGetResponse is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC request to a user-domain Get request.
+func decodeGetRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.GetRequest)
+	return endpoint.GetRequest{Id: req.Id}, nil
+}
+
+// encodeGetResponse is a transport/grpc.EncodeResponseFunc that converts
+// a user-domain response to a gRPC reply.
+func encodeGetResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.GetResponse)
+	if resp.Err != nil {
+		return &pb.GetReply{Status: pb.GetReply_Fail}, resp.Err
+	}
+	return &pb.GetReply{Post: resp.Post, Status: pb.GetReply_Success}, nil
+}
+func (g *grpcServer) Get(ctx context1.Context, req *pb.GetRequest) (*pb.GetReply, error) {
+	_, rep, err := g.get.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetReply), nil
+}
+
+func makeExportUserHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ExportUserEndpoint, decodeExportUserRequest, encodeExportUserResponse, options...)
+}
+
+func decodeExportUserRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ExportUserRequest)
+	return endpoint.ExportUserRequest{UserID: req.UserID}, nil
+}
+
+func encodeExportUserResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ExportUserResponse)
+	if resp.Err != nil {
+		return &pb.ExportUserReply{Status: pb.ExportUserReply_Fail}, resp.Err
+	}
+	return &pb.ExportUserReply{Data: resp.Data, Status: pb.ExportUserReply_Success}, nil
+}
+func (g *grpcServer) ExportUser(ctx context1.Context, req *pb.ExportUserRequest) (*pb.ExportUserReply, error) {
+	_, rep, err := g.exportUser.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ExportUserReply), nil
+}
+
+func makeListByAuthorHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ListByAuthorEndpoint, decodeListByAuthorRequest, encodeListByAuthorResponse, options...)
+}
+
+func decodeListByAuthorRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ListByAuthorRequest)
+	return endpoint.ListByAuthorRequest{UserID: req.UserID, Limit: req.Limit, Cursor: req.Cursor}, nil
+}
+
+func encodeListByAuthorResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ListByAuthorResponse)
+	if resp.Err != nil {
+		return &pb.ListByAuthorReply{Status: pb.ListByAuthorReply_Fail}, resp.Err
+	}
+	return &pb.ListByAuthorReply{Posts: resp.Posts, Total: resp.Total, Next: resp.Next, Status: pb.ListByAuthorReply_Success}, nil
+}
+func (g *grpcServer) ListByAuthor(ctx context1.Context, req *pb.ListByAuthorRequest) (*pb.ListByAuthorReply, error) {
+	_, rep, err := g.listByAuthor.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ListByAuthorReply), nil
+}
+
+func makeFeedHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.FeedEndpoint, decodeFeedRequest, encodeFeedResponse, options...)
+}
+
+func decodeFeedRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.FeedRequest)
+	return endpoint.FeedRequest{UserID: req.UserID, Limit: req.Limit, Cursor: req.Cursor}, nil
+}
+
+func encodeFeedResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.FeedResponse)
+	if resp.Err != nil {
+		return &pb.FeedReply{Status: pb.FeedReply_Fail}, resp.Err
+	}
+	return &pb.FeedReply{Posts: resp.Posts, Next: resp.Next, Status: pb.FeedReply_Success}, nil
+}
+func (g *grpcServer) Feed(ctx context1.Context, req *pb.FeedRequest) (*pb.FeedReply, error) {
+	_, rep, err := g.feed.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.FeedReply), nil
+}
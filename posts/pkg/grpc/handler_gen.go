@@ -9,17 +9,29 @@ import (
 
 // NewGRPCServer makes a set of endpoints available as a gRPC AddServer
 type grpcServer struct {
-	store  grpc.Handler
-	update grpc.Handler
-	list   grpc.Handler
-	delete grpc.Handler
+	store        grpc.Handler
+	update       grpc.Handler
+	list         grpc.Handler
+	delete       grpc.Handler
+	react        grpc.Handler
+	unreact      grpc.Handler
+	get          grpc.Handler
+	exportUser   grpc.Handler
+	listByAuthor grpc.Handler
+	feed         grpc.Handler
 }
 
 func NewGRPCServer(endpoints endpoint.Endpoints, options map[string][]grpc.ServerOption) pb.PostsServer {
 	return &grpcServer{
-		delete: makeDeleteHandler(endpoints, options["Delete"]),
-		list:   makeListHandler(endpoints, options["List"]),
-		store:  makeStoreHandler(endpoints, options["Store"]),
-		update: makeUpdateHandler(endpoints, options["Update"]),
+		delete:       makeDeleteHandler(endpoints, options["Delete"]),
+		exportUser:   makeExportUserHandler(endpoints, options["ExportUser"]),
+		feed:         makeFeedHandler(endpoints, options["Feed"]),
+		get:          makeGetHandler(endpoints, options["Get"]),
+		list:         makeListHandler(endpoints, options["List"]),
+		listByAuthor: makeListByAuthorHandler(endpoints, options["ListByAuthor"]),
+		react:        makeReactHandler(endpoints, options["React"]),
+		store:        makeStoreHandler(endpoints, options["Store"]),
+		unreact:      makeUnreactHandler(endpoints, options["Unreact"]),
+		update:       makeUpdateHandler(endpoints, options["Update"]),
 	}
 }
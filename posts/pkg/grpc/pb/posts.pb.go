@@ -167,6 +167,282 @@ func (DeleteReply_ReplyType) EnumDescriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{8, 0}
 }
 
+type ReactReply_ReplyType int32
+
+const (
+	ReactReply_Success ReactReply_ReplyType = 0
+	ReactReply_Fail    ReactReply_ReplyType = 1
+)
+
+// Enum value maps for ReactReply_ReplyType.
+var (
+	ReactReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ReactReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ReactReply_ReplyType) Enum() *ReactReply_ReplyType {
+	p := new(ReactReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ReactReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReactReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[3].Descriptor()
+}
+
+func (ReactReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[3]
+}
+
+func (x ReactReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReactReply_ReplyType.Descriptor instead.
+func (ReactReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type UnreactReply_ReplyType int32
+
+const (
+	UnreactReply_Success UnreactReply_ReplyType = 0
+	UnreactReply_Fail    UnreactReply_ReplyType = 1
+)
+
+// Enum value maps for UnreactReply_ReplyType.
+var (
+	UnreactReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	UnreactReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x UnreactReply_ReplyType) Enum() *UnreactReply_ReplyType {
+	p := new(UnreactReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x UnreactReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnreactReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[4].Descriptor()
+}
+
+func (UnreactReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[4]
+}
+
+func (x UnreactReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnreactReply_ReplyType.Descriptor instead.
+func (UnreactReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type GetReply_ReplyType int32
+
+const (
+	GetReply_Success GetReply_ReplyType = 0
+	GetReply_Fail    GetReply_ReplyType = 1
+)
+
+// Enum value maps for GetReply_ReplyType.
+var (
+	GetReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	GetReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x GetReply_ReplyType) Enum() *GetReply_ReplyType {
+	p := new(GetReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x GetReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[5].Descriptor()
+}
+
+func (GetReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[5]
+}
+
+func (x GetReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetReply_ReplyType.Descriptor instead.
+func (GetReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type ExportUserReply_ReplyType int32
+
+const (
+	ExportUserReply_Success ExportUserReply_ReplyType = 0
+	ExportUserReply_Fail    ExportUserReply_ReplyType = 1
+)
+
+// Enum value maps for ExportUserReply_ReplyType.
+var (
+	ExportUserReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ExportUserReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ExportUserReply_ReplyType) Enum() *ExportUserReply_ReplyType {
+	p := new(ExportUserReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ExportUserReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportUserReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[6].Descriptor()
+}
+
+func (ExportUserReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[6]
+}
+
+func (x ExportUserReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportUserReply_ReplyType.Descriptor instead.
+func (ExportUserReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type ListByAuthorReply_ReplyType int32
+
+const (
+	ListByAuthorReply_Success ListByAuthorReply_ReplyType = 0
+	ListByAuthorReply_Fail    ListByAuthorReply_ReplyType = 1
+)
+
+// Enum value maps for ListByAuthorReply_ReplyType.
+var (
+	ListByAuthorReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ListByAuthorReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ListByAuthorReply_ReplyType) Enum() *ListByAuthorReply_ReplyType {
+	p := new(ListByAuthorReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ListByAuthorReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListByAuthorReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[7].Descriptor()
+}
+
+func (ListByAuthorReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[7]
+}
+
+func (x ListByAuthorReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListByAuthorReply_ReplyType.Descriptor instead.
+func (ListByAuthorReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type FeedReply_ReplyType int32
+
+const (
+	FeedReply_Success FeedReply_ReplyType = 0
+	FeedReply_Fail    FeedReply_ReplyType = 1
+)
+
+// Enum value maps for FeedReply_ReplyType.
+var (
+	FeedReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	FeedReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x FeedReply_ReplyType) Enum() *FeedReply_ReplyType {
+	p := new(FeedReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x FeedReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[8].Descriptor()
+}
+
+func (FeedReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[8]
+}
+
+func (x FeedReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedReply_ReplyType.Descriptor instead.
+func (FeedReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{20, 0}
+}
+
 type Post struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -182,6 +458,8 @@ type Post struct {
 	// Types that are assignable to CreatedAt:
 	//	*Post_Time
 	CreatedAt isPost_CreatedAt `protobuf_oneof:"createdAt"`
+	Likes     int64            `protobuf:"varint,9,opt,name=likes,proto3" json:"likes,omitempty"`
+	UserID    string           `protobuf:"bytes,10,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
 func (x *Post) Reset() {
@@ -279,6 +557,20 @@ func (x *Post) GetTime() string {
 	return ""
 }
 
+func (x *Post) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *Post) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
 type isPost_CreatedAt interface {
 	isPost_CreatedAt()
 }
@@ -689,148 +981,978 @@ func (x *DeleteReply) GetStatus() DeleteReply_ReplyType {
 	return DeleteReply_Success
 }
 
-var File_posts_proto protoreflect.FileDescriptor
+type ReactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_posts_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
-	0x62, 0x22, 0xc7, 0x01, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b,
-	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2c, 0x0a, 0x0c, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x70,
-	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70,
-	0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x7e, 0x0a, 0x0a, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x2d, 0x0a, 0x0d, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f,
-	0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x0b, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f,
-	0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70,
-	0x6f, 0x73, 0x74, 0x22, 0x2d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f,
-	0x73, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
-	0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46,
-	0x61, 0x69, 0x6c, 0x10, 0x01, 0x32, 0xb6, 0x01, 0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12,
-	0x29, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x2c, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-var (
-	file_posts_proto_rawDescOnce sync.Once
-	file_posts_proto_rawDescData = file_posts_proto_rawDesc
-)
+func (x *ReactRequest) Reset() {
+	*x = ReactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_posts_proto_rawDescGZIP() []byte {
-	file_posts_proto_rawDescOnce.Do(func() {
-		file_posts_proto_rawDescData = protoimpl.X.CompressGZIP(file_posts_proto_rawDescData)
-	})
-	return file_posts_proto_rawDescData
+func (x *ReactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_posts_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_posts_proto_goTypes = []interface{}{
-	(StoreReply_ReplyType)(0),  // 0: pb.StoreReply.ReplyType
-	(UpdateReply_ReplyType)(0), // 1: pb.UpdateReply.ReplyType
-	(DeleteReply_ReplyType)(0), // 2: pb.DeleteReply.ReplyType
-	(*Post)(nil),               // 3: pb.post
-	(*StoreRequest)(nil),       // 4: pb.StoreRequest
-	(*StoreReply)(nil),         // 5: pb.StoreReply
-	(*UpdateRequest)(nil),      // 6: pb.UpdateRequest
-	(*UpdateReply)(nil),        // 7: pb.UpdateReply
-	(*ListRequest)(nil),        // 8: pb.ListRequest
-	(*ListReply)(nil),          // 9: pb.ListReply
-	(*DeleteRequest)(nil),      // 10: pb.DeleteRequest
-	(*DeleteReply)(nil),        // 11: pb.DeleteReply
+func (*ReactRequest) ProtoMessage() {}
+
+func (x *ReactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_posts_proto_depIdxs = []int32{
-	3,  // 0: pb.StoreRequest.post:type_name -> pb.post
-	0,  // 1: pb.StoreReply.status:type_name -> pb.StoreReply.ReplyType
-	3,  // 2: pb.UpdateRequest.post:type_name -> pb.post
-	1,  // 3: pb.UpdateReply.status:type_name -> pb.UpdateReply.ReplyType
-	3,  // 4: pb.ListRequest.post:type_name -> pb.post
-	3,  // 5: pb.ListReply.post:type_name -> pb.post
-	3,  // 6: pb.DeleteRequest.post:type_name -> pb.post
-	2,  // 7: pb.DeleteReply.status:type_name -> pb.DeleteReply.ReplyType
-	4,  // 8: pb.Posts.Store:input_type -> pb.StoreRequest
-	6,  // 9: pb.Posts.Update:input_type -> pb.UpdateRequest
-	8,  // 10: pb.Posts.List:input_type -> pb.ListRequest
-	10, // 11: pb.Posts.Delete:input_type -> pb.DeleteRequest
-	5,  // 12: pb.Posts.Store:output_type -> pb.StoreReply
-	7,  // 13: pb.Posts.Update:output_type -> pb.UpdateReply
-	9,  // 14: pb.Posts.List:output_type -> pb.ListReply
-	11, // 15: pb.Posts.Delete:output_type -> pb.DeleteReply
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+
+// Deprecated: Use ReactRequest.ProtoReflect.Descriptor instead.
+func (*ReactRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{9}
 }
 
-func init() { file_posts_proto_init() }
-func file_posts_proto_init() {
-	if File_posts_proto != nil {
-		return
+func (x *ReactRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_posts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Post); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_posts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreRequest); i {
+	return ""
+}
+
+func (x *ReactRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ReactReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Likes  int64                `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
+	Status ReactReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ReactReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ReactReply) Reset() {
+	*x = ReactReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactReply) ProtoMessage() {}
+
+func (x *ReactReply) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactReply.ProtoReflect.Descriptor instead.
+func (*ReactReply) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReactReply) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *ReactReply) GetStatus() ReactReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ReactReply_Success
+}
+
+type UnreactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *UnreactRequest) Reset() {
+	*x = UnreactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreactRequest) ProtoMessage() {}
+
+func (x *UnreactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreactRequest.ProtoReflect.Descriptor instead.
+func (*UnreactRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UnreactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnreactRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type UnreactReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Likes  int64                  `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
+	Status UnreactReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.UnreactReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *UnreactReply) Reset() {
+	*x = UnreactReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreactReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreactReply) ProtoMessage() {}
+
+func (x *UnreactReply) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreactReply.ProtoReflect.Descriptor instead.
+func (*UnreactReply) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnreactReply) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *UnreactReply) GetStatus() UnreactReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return UnreactReply_Success
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post   *Post              `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Status GetReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.GetReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *GetReply) Reset() {
+	*x = GetReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReply) ProtoMessage() {}
+
+func (x *GetReply) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReply.ProtoReflect.Descriptor instead.
+func (*GetReply) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetReply) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *GetReply) GetStatus() GetReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return GetReply_Success
+}
+
+// ExportUser is called by the users service for the data export of a user.
+type ExportUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ExportUserRequest) Reset() {
+	*x = ExportUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserRequest) ProtoMessage() {}
+
+func (x *ExportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ExportUserReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is a JSON document of the posts and reactions of the user
+	Data   []byte                    `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Status ExportUserReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ExportUserReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ExportUserReply) Reset() {
+	*x = ExportUserReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserReply) ProtoMessage() {}
+
+func (x *ExportUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserReply.ProtoReflect.Descriptor instead.
+func (*ExportUserReply) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportUserReply) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportUserReply) GetStatus() ExportUserReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ExportUserReply_Success
+}
+
+// ListByAuthor pages through the posts of a user, newest first.
+type ListByAuthorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor is the next of the previous page, empty for the first one
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListByAuthorRequest) Reset() {
+	*x = ListByAuthorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListByAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListByAuthorRequest) ProtoMessage() {}
+
+func (x *ListByAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListByAuthorRequest.ProtoReflect.Descriptor instead.
+func (*ListByAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListByAuthorRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ListByAuthorRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListByAuthorRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListByAuthorReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	// total is the number of posts of the user
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// next is empty on the last page
+	Next   string                      `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+	Status ListByAuthorReply_ReplyType `protobuf:"varint,4,opt,name=status,proto3,enum=pb.ListByAuthorReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ListByAuthorReply) Reset() {
+	*x = ListByAuthorReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListByAuthorReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListByAuthorReply) ProtoMessage() {}
+
+func (x *ListByAuthorReply) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListByAuthorReply.ProtoReflect.Descriptor instead.
+func (*ListByAuthorReply) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListByAuthorReply) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListByAuthorReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListByAuthorReply) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *ListByAuthorReply) GetStatus() ListByAuthorReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ListByAuthorReply_Success
+}
+
+// Feed pages through the posts of the authors a user follows, newest first.
+// Posts have no draft state, every stored post counts as published.
+type FeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor is the next of the previous page, empty for the first one
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *FeedRequest) Reset() {
+	*x = FeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedRequest) ProtoMessage() {}
+
+func (x *FeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedRequest.ProtoReflect.Descriptor instead.
+func (*FeedRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FeedRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *FeedRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FeedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type FeedReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	// next is empty on the last page
+	Next   string              `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Status FeedReply_ReplyType `protobuf:"varint,3,opt,name=status,proto3,enum=pb.FeedReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *FeedReply) Reset() {
+	*x = FeedReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedReply) ProtoMessage() {}
+
+func (x *FeedReply) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedReply.ProtoReflect.Descriptor instead.
+func (*FeedReply) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FeedReply) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *FeedReply) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *FeedReply) GetStatus() FeedReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return FeedReply_Success
+}
+
+var File_posts_proto protoreflect.FileDescriptor
+
+var file_posts_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x22, 0xf5, 0x01, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6b, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2c, 0x0a, 0x0c, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x7e, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x2d, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x22, 0x2d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74,
+	0x22, 0x80, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69,
+	0x6c, 0x10, 0x01, 0x22, 0x36, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x78, 0x0a, 0x0a, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46,
+	0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x38, 0x0a, 0x0e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x7c, 0x0a, 0x0c, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x1c, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x52,
+	0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x11, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x35,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x5b, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x05,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69,
+	0x6c, 0x10, 0x01, 0x22, 0x53, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x09, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x52,
+	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x32,
+	0xd9, 0x03, 0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x07, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x12, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x0f, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_posts_proto_rawDescOnce sync.Once
+	file_posts_proto_rawDescData = file_posts_proto_rawDesc
+)
+
+func file_posts_proto_rawDescGZIP() []byte {
+	file_posts_proto_rawDescOnce.Do(func() {
+		file_posts_proto_rawDescData = protoimpl.X.CompressGZIP(file_posts_proto_rawDescData)
+	})
+	return file_posts_proto_rawDescData
+}
+
+var file_posts_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_posts_proto_goTypes = []interface{}{
+	(StoreReply_ReplyType)(0),        // 0: pb.StoreReply.ReplyType
+	(UpdateReply_ReplyType)(0),       // 1: pb.UpdateReply.ReplyType
+	(DeleteReply_ReplyType)(0),       // 2: pb.DeleteReply.ReplyType
+	(ReactReply_ReplyType)(0),        // 3: pb.ReactReply.ReplyType
+	(UnreactReply_ReplyType)(0),      // 4: pb.UnreactReply.ReplyType
+	(GetReply_ReplyType)(0),          // 5: pb.GetReply.ReplyType
+	(ExportUserReply_ReplyType)(0),   // 6: pb.ExportUserReply.ReplyType
+	(ListByAuthorReply_ReplyType)(0), // 7: pb.ListByAuthorReply.ReplyType
+	(FeedReply_ReplyType)(0),         // 8: pb.FeedReply.ReplyType
+	(*Post)(nil),                     // 9: pb.post
+	(*StoreRequest)(nil),             // 10: pb.StoreRequest
+	(*StoreReply)(nil),               // 11: pb.StoreReply
+	(*UpdateRequest)(nil),            // 12: pb.UpdateRequest
+	(*UpdateReply)(nil),              // 13: pb.UpdateReply
+	(*ListRequest)(nil),              // 14: pb.ListRequest
+	(*ListReply)(nil),                // 15: pb.ListReply
+	(*DeleteRequest)(nil),            // 16: pb.DeleteRequest
+	(*DeleteReply)(nil),              // 17: pb.DeleteReply
+	(*ReactRequest)(nil),             // 18: pb.ReactRequest
+	(*ReactReply)(nil),               // 19: pb.ReactReply
+	(*UnreactRequest)(nil),           // 20: pb.UnreactRequest
+	(*UnreactReply)(nil),             // 21: pb.UnreactReply
+	(*GetRequest)(nil),               // 22: pb.GetRequest
+	(*GetReply)(nil),                 // 23: pb.GetReply
+	(*ExportUserRequest)(nil),        // 24: pb.ExportUserRequest
+	(*ExportUserReply)(nil),          // 25: pb.ExportUserReply
+	(*ListByAuthorRequest)(nil),      // 26: pb.ListByAuthorRequest
+	(*ListByAuthorReply)(nil),        // 27: pb.ListByAuthorReply
+	(*FeedRequest)(nil),              // 28: pb.FeedRequest
+	(*FeedReply)(nil),                // 29: pb.FeedReply
+}
+var file_posts_proto_depIdxs = []int32{
+	9,  // 0: pb.StoreRequest.post:type_name -> pb.post
+	0,  // 1: pb.StoreReply.status:type_name -> pb.StoreReply.ReplyType
+	9,  // 2: pb.UpdateRequest.post:type_name -> pb.post
+	1,  // 3: pb.UpdateReply.status:type_name -> pb.UpdateReply.ReplyType
+	9,  // 4: pb.ListRequest.post:type_name -> pb.post
+	9,  // 5: pb.ListReply.post:type_name -> pb.post
+	9,  // 6: pb.DeleteRequest.post:type_name -> pb.post
+	2,  // 7: pb.DeleteReply.status:type_name -> pb.DeleteReply.ReplyType
+	3,  // 8: pb.ReactReply.status:type_name -> pb.ReactReply.ReplyType
+	4,  // 9: pb.UnreactReply.status:type_name -> pb.UnreactReply.ReplyType
+	9,  // 10: pb.GetReply.post:type_name -> pb.post
+	5,  // 11: pb.GetReply.status:type_name -> pb.GetReply.ReplyType
+	6,  // 12: pb.ExportUserReply.status:type_name -> pb.ExportUserReply.ReplyType
+	9,  // 13: pb.ListByAuthorReply.posts:type_name -> pb.post
+	7,  // 14: pb.ListByAuthorReply.status:type_name -> pb.ListByAuthorReply.ReplyType
+	9,  // 15: pb.FeedReply.posts:type_name -> pb.post
+	8,  // 16: pb.FeedReply.status:type_name -> pb.FeedReply.ReplyType
+	10, // 17: pb.Posts.Store:input_type -> pb.StoreRequest
+	12, // 18: pb.Posts.Update:input_type -> pb.UpdateRequest
+	14, // 19: pb.Posts.List:input_type -> pb.ListRequest
+	16, // 20: pb.Posts.Delete:input_type -> pb.DeleteRequest
+	18, // 21: pb.Posts.React:input_type -> pb.ReactRequest
+	20, // 22: pb.Posts.Unreact:input_type -> pb.UnreactRequest
+	22, // 23: pb.Posts.Get:input_type -> pb.GetRequest
+	24, // 24: pb.Posts.ExportUser:input_type -> pb.ExportUserRequest
+	26, // 25: pb.Posts.ListByAuthor:input_type -> pb.ListByAuthorRequest
+	28, // 26: pb.Posts.Feed:input_type -> pb.FeedRequest
+	11, // 27: pb.Posts.Store:output_type -> pb.StoreReply
+	13, // 28: pb.Posts.Update:output_type -> pb.UpdateReply
+	15, // 29: pb.Posts.List:output_type -> pb.ListReply
+	17, // 30: pb.Posts.Delete:output_type -> pb.DeleteReply
+	19, // 31: pb.Posts.React:output_type -> pb.ReactReply
+	21, // 32: pb.Posts.Unreact:output_type -> pb.UnreactReply
+	23, // 33: pb.Posts.Get:output_type -> pb.GetReply
+	25, // 34: pb.Posts.ExportUser:output_type -> pb.ExportUserReply
+	27, // 35: pb.Posts.ListByAuthor:output_type -> pb.ListByAuthorReply
+	29, // 36: pb.Posts.Feed:output_type -> pb.FeedReply
+	27, // [27:37] is the sub-list for method output_type
+	17, // [17:27] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_posts_proto_init() }
+func file_posts_proto_init() {
+	if File_posts_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_posts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Post); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -925,6 +2047,150 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
+		file_posts_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreactReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListByAuthorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListByAuthorReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_posts_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Post_Time)(nil),
@@ -934,8 +2200,8 @@ func file_posts_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_posts_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   9,
+			NumEnums:      9,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -966,6 +2232,12 @@ type PostsClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateReply, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListReply, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
+	React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactReply, error)
+	Unreact(ctx context.Context, in *UnreactRequest, opts ...grpc.CallOption) (*UnreactReply, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetReply, error)
+	ExportUser(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (*ExportUserReply, error)
+	ListByAuthor(ctx context.Context, in *ListByAuthorRequest, opts ...grpc.CallOption) (*ListByAuthorReply, error)
+	Feed(ctx context.Context, in *FeedRequest, opts ...grpc.CallOption) (*FeedReply, error)
 }
 
 type postsClient struct {
@@ -1012,12 +2284,72 @@ func (c *postsClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grp
 	return out, nil
 }
 
+func (c *postsClient) React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactReply, error) {
+	out := new(ReactReply)
+	err := c.cc.Invoke(ctx, "/pb.Posts/React", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) Unreact(ctx context.Context, in *UnreactRequest, opts ...grpc.CallOption) (*UnreactReply, error) {
+	out := new(UnreactReply)
+	err := c.cc.Invoke(ctx, "/pb.Posts/Unreact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetReply, error) {
+	out := new(GetReply)
+	err := c.cc.Invoke(ctx, "/pb.Posts/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) ExportUser(ctx context.Context, in *ExportUserRequest, opts ...grpc.CallOption) (*ExportUserReply, error) {
+	out := new(ExportUserReply)
+	err := c.cc.Invoke(ctx, "/pb.Posts/ExportUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) ListByAuthor(ctx context.Context, in *ListByAuthorRequest, opts ...grpc.CallOption) (*ListByAuthorReply, error) {
+	out := new(ListByAuthorReply)
+	err := c.cc.Invoke(ctx, "/pb.Posts/ListByAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) Feed(ctx context.Context, in *FeedRequest, opts ...grpc.CallOption) (*FeedReply, error) {
+	out := new(FeedReply)
+	err := c.cc.Invoke(ctx, "/pb.Posts/Feed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostsServer is the server API for Posts service.
 type PostsServer interface {
 	Store(context.Context, *StoreRequest) (*StoreReply, error)
 	Update(context.Context, *UpdateRequest) (*UpdateReply, error)
 	List(context.Context, *ListRequest) (*ListReply, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteReply, error)
+	React(context.Context, *ReactRequest) (*ReactReply, error)
+	Unreact(context.Context, *UnreactRequest) (*UnreactReply, error)
+	Get(context.Context, *GetRequest) (*GetReply, error)
+	ExportUser(context.Context, *ExportUserRequest) (*ExportUserReply, error)
+	ListByAuthor(context.Context, *ListByAuthorRequest) (*ListByAuthorReply, error)
+	Feed(context.Context, *FeedRequest) (*FeedReply, error)
 }
 
 // UnimplementedPostsServer can be embedded to have forward compatible implementations.
@@ -1036,6 +2368,24 @@ func (*UnimplementedPostsServer) List(context.Context, *ListRequest) (*ListReply
 func (*UnimplementedPostsServer) Delete(context.Context, *DeleteRequest) (*DeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedPostsServer) React(context.Context, *ReactRequest) (*ReactReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method React not implemented")
+}
+func (*UnimplementedPostsServer) Unreact(context.Context, *UnreactRequest) (*UnreactReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unreact not implemented")
+}
+func (*UnimplementedPostsServer) Get(context.Context, *GetRequest) (*GetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedPostsServer) ExportUser(context.Context, *ExportUserRequest) (*ExportUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUser not implemented")
+}
+func (*UnimplementedPostsServer) ListByAuthor(context.Context, *ListByAuthorRequest) (*ListByAuthorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListByAuthor not implemented")
+}
+func (*UnimplementedPostsServer) Feed(context.Context, *FeedRequest) (*FeedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feed not implemented")
+}
 
 func RegisterPostsServer(s *grpc.Server, srv PostsServer) {
 	s.RegisterService(&_Posts_serviceDesc, srv)
@@ -1113,6 +2463,114 @@ func _Posts_Delete_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Posts_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Posts/React",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).React(ctx, req.(*ReactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_Unreact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnreactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).Unreact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Posts/Unreact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).Unreact(ctx, req.(*UnreactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Posts/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_ExportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).ExportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Posts/ExportUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).ExportUser(ctx, req.(*ExportUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_ListByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).ListByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Posts/ListByAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).ListByAuthor(ctx, req.(*ListByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_Feed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).Feed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Posts/Feed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).Feed(ctx, req.(*FeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Posts_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Posts",
 	HandlerType: (*PostsServer)(nil),
@@ -1133,6 +2591,30 @@ var _Posts_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Posts_Delete_Handler,
 		},
+		{
+			MethodName: "React",
+			Handler:    _Posts_React_Handler,
+		},
+		{
+			MethodName: "Unreact",
+			Handler:    _Posts_Unreact_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Posts_Get_Handler,
+		},
+		{
+			MethodName: "ExportUser",
+			Handler:    _Posts_ExportUser_Handler,
+		},
+		{
+			MethodName: "ListByAuthor",
+			Handler:    _Posts_ListByAuthor_Handler,
+		},
+		{
+			MethodName: "Feed",
+			Handler:    _Posts_Feed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "posts.proto",
@@ -2,12 +2,14 @@ package model
 
 // Post struct
 type Post struct {
-	ID          string
+	ID          string `bson:"_id,omitempty"`
 	Token       *string
+	UserID      string `bson:"user_id"`
 	Title       string
 	Slug        string
 	Description string
 	Body        string
 	Header      string
 	CreatedAT   *string
+	Likes       int64
 }
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"encoding/base64"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCursor is returned for a page cursor that was not made by this service.
+var ErrCursor = status.Error(codes.InvalidArgument, "invalid cursor")
+
+const (
+	defaultListLimit int64 = 20
+	maxListLimit     int64 = 100
+)
+
+// limit returns n within the page size bounds.
+func limit(n int64) int64 {
+	if n <= 0 {
+		return defaultListLimit
+	}
+	if n > maxListLimit {
+		return maxListLimit
+	}
+	return n
+}
+
+// Pages run newest first by _id, so the cursor is the _id of the last post
+// of a page.
+func encodeCursor(id string) string {
+	if id == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+func decodeCursor(s string) (primitive.ObjectID, error) {
+	bt, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return primitive.NilObjectID, ErrCursor
+	}
+	oid, err := primitive.ObjectIDFromHex(string(bt))
+	if err != nil {
+		return primitive.NilObjectID, ErrCursor
+	}
+	return oid, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/opentracing/opentracing-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+
+	"github.com/emadghaffari/kit-blog/posts/config"
+	"github.com/emadghaffari/kit-blog/posts/pkg/grpc/pb"
+	us "github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
+)
+
+// ErrNoFeed is returned when the users service, which knows who follows
+// whom, could not be reached at start.
+var ErrNoFeed = errors.New("feed is not available")
+
+// maxFollowing is how many followed authors fanOutOnRead reads posts of,
+// the most recently followed first.
+const maxFollowing = 1000
+
+// Feeder builds the feed of a user, the posts of the authors they follow
+// newest first. Every post is published once stored.
+type Feeder interface {
+	Feed(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, next string, err error)
+}
+
+// fanOutOnRead asks the users service whom the user follows and queries
+// their posts on every read. It needs no writes and no backfill, but costs
+// grow with the number of followed authors; a feed materialized when posts
+// are stored can take its place as another Feeder.
+type fanOutOnRead struct {
+	users us.UsersClient
+	posts *mongo.Collection
+}
+
+// Feed implements Feeder.
+func (f *fanOutOnRead) Feed(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, next string, err error) {
+	authors, err := f.following(ctx, userID)
+	if err != nil {
+		return []*pb.Post{}, "", err
+	}
+	if len(authors) == 0 {
+		return []*pb.Post{}, "", nil
+	}
+
+	filter := bson.M{"user_id": bson.M{"$in": authors}}
+	if cursor != "" {
+		after, err := decodeCursor(cursor)
+		if err != nil {
+			return []*pb.Post{}, "", err
+		}
+		filter["_id"] = bson.M{"$lt": after}
+	}
+	return page(f.posts, filter, limit)
+}
+
+// following returns the ids of the authors userID follows.
+func (f *fanOutOnRead) following(ctx context.Context, userID string) ([]string, error) {
+	authors := []string{}
+	cursor := ""
+	for len(authors) < maxFollowing {
+		res, err := f.users.ListFollowing(ctx, &us.ListFollowingRequest{Id: userID, Limit: maxListLimit, Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range res.Items {
+			authors = append(authors, item.Followee)
+		}
+		if res.Next == "" {
+			break
+		}
+		cursor = res.Next
+	}
+	return authors, nil
+}
+
+func initUsers() (*grpc.ClientConn, error) {
+	return grpc.Dial(config.Confs.Users.Host,
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(otgrpc.OpenTracingClientInterceptor(opentracing.GlobalTracer())))
+}
@@ -50,3 +50,39 @@ func (l loggingMiddleware) Delete(ctx context.Context, post model.Post) (respons
 	}()
 	return l.next.Delete(ctx, post)
 }
+func (l loggingMiddleware) React(ctx context.Context, userID string, postID string) (likes int64, err error) {
+	defer func() {
+		l.logger.Log("method", "React", "userID", userID, "postID", postID, "likes", likes, "err", err)
+	}()
+	return l.next.React(ctx, userID, postID)
+}
+func (l loggingMiddleware) Unreact(ctx context.Context, userID string, postID string) (likes int64, err error) {
+	defer func() {
+		l.logger.Log("method", "Unreact", "userID", userID, "postID", postID, "likes", likes, "err", err)
+	}()
+	return l.next.Unreact(ctx, userID, postID)
+}
+func (l loggingMiddleware) Get(ctx context.Context, id string) (post *pb.Post, err error) {
+	defer func() {
+		l.logger.Log("method", "Get", "id", id, "post", post, "err", err)
+	}()
+	return l.next.Get(ctx, id)
+}
+func (l loggingMiddleware) ExportUser(ctx context.Context, userID string) (data []byte, err error) {
+	defer func() {
+		l.logger.Log("method", "ExportUser", "userID", userID, "err", err)
+	}()
+	return l.next.ExportUser(ctx, userID)
+}
+func (l loggingMiddleware) ListByAuthor(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, total int64, next string, err error) {
+	defer func() {
+		l.logger.Log("method", "ListByAuthor", "userID", userID, "limit", limit, "cursor", cursor, "total", total, "next", next, "err", err)
+	}()
+	return l.next.ListByAuthor(ctx, userID, limit, cursor)
+}
+func (l loggingMiddleware) Feed(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, next string, err error) {
+	defer func() {
+		l.logger.Log("method", "Feed", "userID", userID, "limit", limit, "cursor", cursor, "next", next, "err", err)
+	}()
+	return l.next.Feed(ctx, userID, limit, cursor)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"time"
 
@@ -11,8 +12,12 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/emadghaffari/kit-blog/posts/config"
 	"github.com/emadghaffari/kit-blog/posts/pkg/grpc/pb"
 	model "github.com/emadghaffari/kit-blog/posts/pkg/model"
+	"github.com/emadghaffari/kit-blog/users/pkg/auth"
+	"github.com/emadghaffari/kit-blog/users/pkg/events"
+	us "github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
 )
 
 // PostsService describes the service.
@@ -22,10 +27,18 @@ type PostsService interface {
 	Update(ctx context.Context, post model.Post) (response string, err error)
 	List(ctx context.Context, post model.Post) (response []*pb.Post, err error)
 	Delete(ctx context.Context, post model.Post) (response string, err error)
+	React(ctx context.Context, userID string, postID string) (likes int64, err error)
+	Unreact(ctx context.Context, userID string, postID string) (likes int64, err error)
+	Get(ctx context.Context, id string) (post *pb.Post, err error)
+	ExportUser(ctx context.Context, userID string) (data []byte, err error)
+	ListByAuthor(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, total int64, next string, err error)
+	Feed(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, next string, err error)
 }
 
 type basicPostsService struct {
-	db *mongo.Collection
+	db        *mongo.Collection
+	reactions *mongo.Collection
+	feed      Feeder
 }
 
 func (b *basicPostsService) Store(ctx context.Context, post model.Post) (response string, err error) {
@@ -33,6 +46,11 @@ func (b *basicPostsService) Store(ctx context.Context, post model.Post) (respons
 	span := tracer.StartSpan("store")
 	defer span.Finish()
 
+	// the author is whoever signed the request
+	if id, ok := auth.FromContext(ctx); ok {
+		post.UserID = id.UserID
+	}
+
 	values := bson.M{
 		"title":       post.Title,
 		"slug":        post.Slug,
@@ -40,6 +58,8 @@ func (b *basicPostsService) Store(ctx context.Context, post model.Post) (respons
 		"body":        post.Body,
 		"header":      post.Header,
 		"createdAt":   post.CreatedAT,
+		"user_id":     post.UserID,
+		"likes":       0,
 	}
 	res, err := b.db.InsertOne(context.Background(), values)
 
@@ -65,6 +85,9 @@ func (b *basicPostsService) Update(ctx context.Context, post model.Post) (respon
 	if err != nil {
 		return "FAILD", err
 	}
+	if err := b.allow(ctx, oid); err != nil {
+		return "FAILD", err
+	}
 
 	filter := bson.M{"_id": oid}
 	values := bson.M{
@@ -75,7 +98,8 @@ func (b *basicPostsService) Update(ctx context.Context, post model.Post) (respon
 		"header":      post.Header,
 		"createdAt":   post.CreatedAT,
 	}
-	_, err = b.db.ReplaceOne(context.Background(), filter, values)
+	// $set keeps the reaction counters untouched
+	_, err = b.db.UpdateOne(context.Background(), filter, bson.M{"$set": values})
 	if err != nil {
 		return "FAILD", nil
 	}
@@ -100,20 +124,118 @@ func (b *basicPostsService) List(ctx context.Context, post model.Post) (response
 		if err != nil {
 			return items, nil
 		}
-		items = append(items,
-			&pb.Post{
-				Title:       data.Title,
-				Body:        data.Body,
-				Slug:        data.Slug,
-				Description: data.Description,
-				CreatedAt:   &pb.Post_Time{Time: *data.CreatedAT},
-				Header:      data.Header,
-			},
-		)
+		items = append(items, toPB(data))
 	}
 
 	return items, err
 }
+
+// Get returns a single post.
+func (b *basicPostsService) Get(ctx context.Context, id string) (post *pb.Post, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("get")
+	defer span.Finish()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &model.Post{}
+	if err := b.db.FindOne(context.Background(), bson.M{"_id": oid}).Decode(data); err != nil {
+		return nil, err
+	}
+	return toPB(data), nil
+}
+
+// ListByAuthor returns a page of the posts of userID, newest first, and
+// how many posts the user has, for author pages.
+func (b *basicPostsService) ListByAuthor(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, total int64, next string, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("list_by_author")
+	defer span.Finish()
+
+	posts = []*pb.Post{}
+	// posts of deleted users are anonymized and have no author
+	if userID == "" {
+		return posts, 0, "", nil
+	}
+	filter := bson.M{"user_id": userID}
+	total, err = b.db.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return posts, 0, "", err
+	}
+	if cursor != "" {
+		after, err := decodeCursor(cursor)
+		if err != nil {
+			return posts, 0, "", err
+		}
+		filter["_id"] = bson.M{"$lt": after}
+	}
+
+	posts, next, err = page(b.db, filter, limit)
+	return posts, total, next, err
+}
+
+// Feed returns a page of the feed of userID. Posts have no draft state, so
+// every post of a followed author is in it.
+func (b *basicPostsService) Feed(ctx context.Context, userID string, limit int64, cursor string) (posts []*pb.Post, next string, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("feed")
+	defer span.Finish()
+
+	if err := auth.Allow(ctx, userID, auth.RoleAdmin); err != nil {
+		return []*pb.Post{}, "", err
+	}
+	if b.feed == nil {
+		return []*pb.Post{}, "", ErrNoFeed
+	}
+	return b.feed.Feed(ctx, userID, limit, cursor)
+}
+
+// page returns the posts of db matching filter, newest first, up to limit
+// and the cursor of the next page.
+func page(db *mongo.Collection, filter bson.M, n int64) (posts []*pb.Post, next string, err error) {
+	n = limit(n)
+	posts = []*pb.Post{}
+	// one more than asked tells whether there is a next page
+	opts := options.Find().SetSort(bson.M{"_id": -1}).SetLimit(n + 1)
+	cur, err := db.Find(context.Background(), filter, opts)
+	if err != nil {
+		return posts, "", err
+	}
+	defer cur.Close(context.Background())
+
+	for cur.Next(context.Background()) {
+		data := &model.Post{}
+		if err := cur.Decode(data); err != nil {
+			return posts, "", err
+		}
+		if int64(len(posts)) == n {
+			next = encodeCursor(posts[len(posts)-1].Id)
+			break
+		}
+		posts = append(posts, toPB(data))
+	}
+	return posts, next, cur.Err()
+}
+
+func toPB(data *model.Post) *pb.Post {
+	post := &pb.Post{
+		Id:          data.ID,
+		UserID:      data.UserID,
+		Title:       data.Title,
+		Body:        data.Body,
+		Slug:        data.Slug,
+		Description: data.Description,
+		Header:      data.Header,
+		Likes:       data.Likes,
+	}
+	if data.CreatedAT != nil {
+		post.CreatedAt = &pb.Post_Time{Time: *data.CreatedAT}
+	}
+	return post
+}
 func (b *basicPostsService) Delete(ctx context.Context, post model.Post) (response string, err error) {
 	tracer := opentracing.GlobalTracer()
 	span := tracer.StartSpan("delete")
@@ -123,6 +245,9 @@ func (b *basicPostsService) Delete(ctx context.Context, post model.Post) (respon
 	if err != nil {
 		return "FAILD", err
 	}
+	if err := b.allow(ctx, oid); err != nil {
+		return "FAILD", err
+	}
 
 	filter := bson.M{"_id": oid}
 	_, err = b.db.DeleteOne(context.Background(), filter)
@@ -133,15 +258,211 @@ func (b *basicPostsService) Delete(ctx context.Context, post model.Post) (respon
 	return oid.Hex(), err
 }
 
+// allow lets the author of the post and editors change it.
+func (b *basicPostsService) allow(ctx context.Context, oid primitive.ObjectID) error {
+	data := model.Post{}
+	if err := b.db.FindOne(context.Background(), bson.M{"_id": oid}).Decode(&data); err != nil {
+		return err
+	}
+	return auth.Allow(ctx, data.UserID, auth.RoleEditor)
+}
+
+// React records a like from userID on the post. A user can like a
+// post only once, so repeated calls leave the counter as it is.
+func (b *basicPostsService) React(ctx context.Context, userID string, postID string) (likes int64, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("react")
+	defer span.Finish()
+
+	if id, ok := auth.FromContext(ctx); ok {
+		userID = id.UserID
+	}
+
+	oid, err := primitive.ObjectIDFromHex(postID)
+	if err != nil {
+		return 0, err
+	}
+
+	reaction := bson.M{"user_id": userID, "target_id": postID}
+	_, err = b.reactions.InsertOne(context.Background(), bson.M{
+		"user_id":   userID,
+		"target_id": postID,
+		"createdAt": time.Now().UTC(),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return b.likes(oid)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	likes, err = b.incLikes(oid, 1)
+	if err != nil {
+		// the post is gone, do not keep a dangling reaction
+		b.reactions.DeleteOne(context.Background(), reaction)
+		return 0, err
+	}
+	return likes, nil
+}
+
+// Unreact removes the like of userID from the post, if there is one.
+func (b *basicPostsService) Unreact(ctx context.Context, userID string, postID string) (likes int64, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("unreact")
+	defer span.Finish()
+
+	if id, ok := auth.FromContext(ctx); ok {
+		userID = id.UserID
+	}
+
+	oid, err := primitive.ObjectIDFromHex(postID)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := b.reactions.DeleteOne(context.Background(), bson.M{"user_id": userID, "target_id": postID})
+	if err != nil {
+		return 0, err
+	}
+	if res.DeletedCount == 0 {
+		return b.likes(oid)
+	}
+
+	return b.incLikes(oid, -1)
+}
+
+// incLikes moves the likes counter of a post by n.
+func (b *basicPostsService) incLikes(oid primitive.ObjectID, n int64) (int64, error) {
+	data := model.Post{}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := b.db.FindOneAndUpdate(context.Background(), bson.M{"_id": oid}, bson.M{"$inc": bson.M{"likes": n}}, opts)
+	if err := res.Decode(&data); err != nil {
+		return 0, err
+	}
+	return data.Likes, nil
+}
+
+func (b *basicPostsService) likes(oid primitive.ObjectID) (int64, error) {
+	data := model.Post{}
+	if err := b.db.FindOne(context.Background(), bson.M{"_id": oid}).Decode(&data); err != nil {
+		return 0, err
+	}
+	return data.Likes, nil
+}
+
+// ExportUser returns the posts and likes of the user as JSON, for the data
+// export of the users service.
+func (b *basicPostsService) ExportUser(ctx context.Context, userID string) (data []byte, err error) {
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("export_user")
+	defer span.Finish()
+
+	if err := auth.Allow(ctx, userID, auth.RoleAdmin, auth.RoleService); err != nil {
+		return nil, err
+	}
+
+	// every stored field, an export holds all we know
+	export := struct {
+		Posts     []bson.M `json:"posts"`
+		Reactions []bson.M `json:"reactions"`
+	}{}
+	cur, err := b.db.Find(context.Background(), bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	if err := cur.All(context.Background(), &export.Posts); err != nil {
+		return nil, err
+	}
+	cur, err = b.reactions.Find(context.Background(), bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	if err := cur.All(context.Background(), &export.Reactions); err != nil {
+		return nil, err
+	}
+	return json.Marshal(export)
+}
+
 // NewBasicPostsService returns a naive, stateless implementation of PostsService.
 func NewBasicPostsService() PostsService {
 	col, err := initMongoDB()
 	if err != nil {
 		return new(basicPostsService)
 	}
-	return &basicPostsService{
-		db: col,
+	b := &basicPostsService{
+		db:        col,
+		reactions: col.Database().Collection("reactions"),
+	}
+	if conn, err := initUsers(); err == nil {
+		b.feed = &fanOutOnRead{users: us.NewUsersClient(conn), posts: col}
+	}
+	go events.NewOutbox(col.Database().Client()).Consume(context.Background(), "posts", time.Minute, b.userDeleted, events.UserDeleted)
+	return b
+}
+
+// userDeleted drops the likes of a deleted user and deletes or anonymizes
+// their posts, as configured.
+func (b *basicPostsService) userDeleted(ctx context.Context, e events.Event) error {
+	if err := b.dropReactions(ctx, e.UserID); err != nil {
+		return err
+	}
+
+	if config.Confs.DeletedUsers.Policy != events.PolicyDelete {
+		_, err := b.db.UpdateMany(ctx, bson.M{"user_id": e.UserID}, bson.M{"$set": bson.M{"user_id": ""}})
+		return err
+	}
+
+	cur, err := b.db.Find(ctx, bson.M{"user_id": e.UserID}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	posts := []model.Post{}
+	if err := cur.All(ctx, &posts); err != nil {
+		return err
+	}
+	ids := make([]string, len(posts))
+	for i, p := range posts {
+		ids[i] = p.ID
+	}
+	if _, err := b.reactions.DeleteMany(ctx, bson.M{"target_id": bson.M{"$in": ids}}); err != nil {
+		return err
 	}
+	_, err = b.db.DeleteMany(ctx, bson.M{"user_id": e.UserID})
+	return err
+}
+
+// dropReactions removes the likes of userID and takes them off the
+// counters of the posts.
+func (b *basicPostsService) dropReactions(ctx context.Context, userID string) error {
+	cur, err := b.reactions.Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		r := struct {
+			ID       primitive.ObjectID `bson:"_id"`
+			TargetID string             `bson:"target_id"`
+		}{}
+		if err := cur.Decode(&r); err != nil {
+			return err
+		}
+		// only the call that removed the reaction moves the counter
+		res, err := b.reactions.DeleteOne(ctx, bson.M{"_id": r.ID})
+		if err != nil {
+			return err
+		}
+		if res.DeletedCount == 0 {
+			continue
+		}
+		if oid, err := primitive.ObjectIDFromHex(r.TargetID); err == nil {
+			if _, err := b.incLikes(oid, -1); err != nil && err != mongo.ErrNoDocuments {
+				return err
+			}
+		}
+	}
+	return cur.Err()
 }
 
 // New returns a PostsService with all of the expected middleware wired in.
@@ -168,6 +489,25 @@ func initMongoDB() (*mongo.Collection, error) {
 
 	posts := client.Database("kit-posts").Collection("posts")
 
+	// author pages list the posts of a user newest first
+	_, err = posts.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		log.Printf(err.Error())
+		return nil, err
+	}
+
+	// one reaction per user per post
+	_, err = posts.Database().Collection("reactions").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "target_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		log.Printf(err.Error())
+		return nil, err
+	}
+
 	return posts, nil
 
 }
@@ -28,11 +28,6 @@ func New(instance string, options map[string][]http.ClientOption) (service.Users
 	if err != nil {
 		return nil, err
 	}
-	var getEndpoint endpoint.Endpoint
-	{
-		getEndpoint = http.NewClient("POST", copyURL(u, "/get"), encodeHTTPGenericRequest, decodeGetResponse, options["Get"]...).Endpoint()
-	}
-
 	var loginEndpoint endpoint.Endpoint
 	{
 		loginEndpoint = http.NewClient("POST", copyURL(u, "/login"), encodeHTTPGenericRequest, decodeLoginResponse, options["Login"]...).Endpoint()
@@ -43,10 +38,165 @@ func New(instance string, options map[string][]http.ClientOption) (service.Users
 		registerEndpoint = http.NewClient("POST", copyURL(u, "/register"), encodeHTTPGenericRequest, decodeRegisterResponse, options["Register"]...).Endpoint()
 	}
 
+	var requestVerificationEndpoint endpoint.Endpoint
+	{
+		requestVerificationEndpoint = http.NewClient("POST", copyURL(u, "/verification/request"), encodeHTTPGenericRequest, decodeRequestVerificationResponse, options["RequestVerification"]...).Endpoint()
+	}
+
+	var confirmVerificationEndpoint endpoint.Endpoint
+	{
+		confirmVerificationEndpoint = http.NewClient("POST", copyURL(u, "/verification/confirm"), encodeHTTPGenericRequest, decodeConfirmVerificationResponse, options["ConfirmVerification"]...).Endpoint()
+	}
+
+	var requestPasswordResetEndpoint endpoint.Endpoint
+	{
+		requestPasswordResetEndpoint = http.NewClient("POST", copyURL(u, "/password/forgot"), encodeHTTPGenericRequest, decodeRequestPasswordResetResponse, options["RequestPasswordReset"]...).Endpoint()
+	}
+
+	var resetPasswordEndpoint endpoint.Endpoint
+	{
+		resetPasswordEndpoint = http.NewClient("POST", copyURL(u, "/password/reset"), encodeHTTPGenericRequest, decodeResetPasswordResponse, options["ResetPassword"]...).Endpoint()
+	}
+
+	var updateProfileEndpoint endpoint.Endpoint
+	{
+		updateProfileEndpoint = http.NewClient("POST", copyURL(u, "/profile"), encodeHTTPGenericRequest, decodeUpdateProfileResponse, options["UpdateProfile"]...).Endpoint()
+	}
+
+	var changePasswordEndpoint endpoint.Endpoint
+	{
+		changePasswordEndpoint = http.NewClient("POST", copyURL(u, "/password/change"), encodeHTTPGenericRequest, decodeChangePasswordResponse, options["ChangePassword"]...).Endpoint()
+	}
+
+	var enrollTOTPEndpoint endpoint.Endpoint
+	{
+		enrollTOTPEndpoint = http.NewClient("POST", copyURL(u, "/2fa/enroll"), encodeHTTPGenericRequest, decodeEnrollTOTPResponse, options["EnrollTOTP"]...).Endpoint()
+	}
+
+	var confirmTOTPEndpoint endpoint.Endpoint
+	{
+		confirmTOTPEndpoint = http.NewClient("POST", copyURL(u, "/2fa/confirm"), encodeHTTPGenericRequest, decodeConfirmTOTPResponse, options["ConfirmTOTP"]...).Endpoint()
+	}
+
+	var verifySecondFactorEndpoint endpoint.Endpoint
+	{
+		verifySecondFactorEndpoint = http.NewClient("POST", copyURL(u, "/2fa/verify"), encodeHTTPGenericRequest, decodeVerifySecondFactorResponse, options["VerifySecondFactor"]...).Endpoint()
+	}
+
+	var listSessionsEndpoint endpoint.Endpoint
+	{
+		listSessionsEndpoint = http.NewClient("POST", copyURL(u, "/sessions"), encodeHTTPGenericRequest, decodeListSessionsResponse, options["ListSessions"]...).Endpoint()
+	}
+
+	var revokeSessionEndpoint endpoint.Endpoint
+	{
+		revokeSessionEndpoint = http.NewClient("POST", copyURL(u, "/sessions/revoke"), encodeHTTPGenericRequest, decodeRevokeSessionResponse, options["RevokeSession"]...).Endpoint()
+	}
+
+	var oIDCLoginEndpoint endpoint.Endpoint
+	{
+		oIDCLoginEndpoint = http.NewClient("POST", copyURL(u, "/oidc/login"), encodeHTTPGenericRequest, decodeOIDCLoginResponse, options["OIDCLogin"]...).Endpoint()
+	}
+
+	var oIDCCallbackEndpoint endpoint.Endpoint
+	{
+		oIDCCallbackEndpoint = http.NewClient("POST", copyURL(u, "/oidc/callback"), encodeHTTPGenericRequest, decodeOIDCCallbackResponse, options["OIDCCallback"]...).Endpoint()
+	}
+
+	var createAPIKeyEndpoint endpoint.Endpoint
+	{
+		createAPIKeyEndpoint = http.NewClient("POST", copyURL(u, "/keys/create"), encodeHTTPGenericRequest, decodeCreateAPIKeyResponse, options["CreateAPIKey"]...).Endpoint()
+	}
+
+	var listAPIKeysEndpoint endpoint.Endpoint
+	{
+		listAPIKeysEndpoint = http.NewClient("POST", copyURL(u, "/keys"), encodeHTTPGenericRequest, decodeListAPIKeysResponse, options["ListAPIKeys"]...).Endpoint()
+	}
+
+	var revokeAPIKeyEndpoint endpoint.Endpoint
+	{
+		revokeAPIKeyEndpoint = http.NewClient("POST", copyURL(u, "/keys/revoke"), encodeHTTPGenericRequest, decodeRevokeAPIKeyResponse, options["RevokeAPIKey"]...).Endpoint()
+	}
+
+	var deleteAccountEndpoint endpoint.Endpoint
+	{
+		deleteAccountEndpoint = http.NewClient("POST", copyURL(u, "/account/delete"), encodeHTTPGenericRequest, decodeDeleteAccountResponse, options["DeleteAccount"]...).Endpoint()
+	}
+
+	var exportMyDataEndpoint endpoint.Endpoint
+	{
+		exportMyDataEndpoint = http.NewClient("POST", copyURL(u, "/export"), encodeHTTPGenericRequest, decodeExportMyDataResponse, options["ExportMyData"]...).Endpoint()
+	}
+
+	var exportStatusEndpoint endpoint.Endpoint
+	{
+		exportStatusEndpoint = http.NewClient("POST", copyURL(u, "/export/status"), encodeHTTPGenericRequest, decodeExportStatusResponse, options["ExportStatus"]...).Endpoint()
+	}
+
+	var getPublicProfileEndpoint endpoint.Endpoint
+	{
+		getPublicProfileEndpoint = http.NewClient("POST", copyURL(u, "/profile"), encodeHTTPGenericRequest, decodeGetPublicProfileResponse, options["GetPublicProfile"]...).Endpoint()
+	}
+
+	var updatePublicProfileEndpoint endpoint.Endpoint
+	{
+		updatePublicProfileEndpoint = http.NewClient("POST", copyURL(u, "/profile/update"), encodeHTTPGenericRequest, decodeUpdatePublicProfileResponse, options["UpdatePublicProfile"]...).Endpoint()
+	}
+
+	var followEndpoint endpoint.Endpoint
+	{
+		followEndpoint = http.NewClient("POST", copyURL(u, "/follow"), encodeHTTPGenericRequest, decodeFollowResponse, options["Follow"]...).Endpoint()
+	}
+
+	var unfollowEndpoint endpoint.Endpoint
+	{
+		unfollowEndpoint = http.NewClient("POST", copyURL(u, "/unfollow"), encodeHTTPGenericRequest, decodeUnfollowResponse, options["Unfollow"]...).Endpoint()
+	}
+
+	var listFollowersEndpoint endpoint.Endpoint
+	{
+		listFollowersEndpoint = http.NewClient("POST", copyURL(u, "/followers"), encodeHTTPGenericRequest, decodeListFollowersResponse, options["ListFollowers"]...).Endpoint()
+	}
+
+	var listFollowingEndpoint endpoint.Endpoint
+	{
+		listFollowingEndpoint = http.NewClient("POST", copyURL(u, "/following"), encodeHTTPGenericRequest, decodeListFollowingResponse, options["ListFollowing"]...).Endpoint()
+	}
+
+	var cancelDeletionEndpoint endpoint.Endpoint
+	{
+		cancelDeletionEndpoint = http.NewClient("POST", copyURL(u, "/account/cancel-deletion"), encodeHTTPGenericRequest, decodeCancelDeletionResponse, options["CancelDeletion"]...).Endpoint()
+	}
+
 	return endpoint1.Endpoints{
-		GetEndpoint:      getEndpoint,
-		LoginEndpoint:    loginEndpoint,
-		RegisterEndpoint: registerEndpoint,
+		CancelDeletionEndpoint:       cancelDeletionEndpoint,
+		ChangePasswordEndpoint:       changePasswordEndpoint,
+		ConfirmTOTPEndpoint:          confirmTOTPEndpoint,
+		ConfirmVerificationEndpoint:  confirmVerificationEndpoint,
+		CreateAPIKeyEndpoint:         createAPIKeyEndpoint,
+		DeleteAccountEndpoint:        deleteAccountEndpoint,
+		EnrollTOTPEndpoint:           enrollTOTPEndpoint,
+		ExportMyDataEndpoint:         exportMyDataEndpoint,
+		ExportStatusEndpoint:         exportStatusEndpoint,
+		FollowEndpoint:               followEndpoint,
+		GetPublicProfileEndpoint:     getPublicProfileEndpoint,
+		ListAPIKeysEndpoint:          listAPIKeysEndpoint,
+		ListFollowersEndpoint:        listFollowersEndpoint,
+		ListFollowingEndpoint:        listFollowingEndpoint,
+		ListSessionsEndpoint:         listSessionsEndpoint,
+		LoginEndpoint:                loginEndpoint,
+		OIDCCallbackEndpoint:         oIDCCallbackEndpoint,
+		OIDCLoginEndpoint:            oIDCLoginEndpoint,
+		RegisterEndpoint:             registerEndpoint,
+		RequestPasswordResetEndpoint: requestPasswordResetEndpoint,
+		RequestVerificationEndpoint:  requestVerificationEndpoint,
+		ResetPasswordEndpoint:        resetPasswordEndpoint,
+		RevokeAPIKeyEndpoint:         revokeAPIKeyEndpoint,
+		RevokeSessionEndpoint:        revokeSessionEndpoint,
+		UnfollowEndpoint:             unfollowEndpoint,
+		UpdateProfileEndpoint:        updateProfileEndpoint,
+		UpdatePublicProfileEndpoint:  updatePublicProfileEndpoint,
+		VerifySecondFactorEndpoint:   verifySecondFactorEndpoint,
 	}, nil
 }
 
@@ -62,41 +212,366 @@ func encodeHTTPGenericRequest(_ context.Context, r *http1.Request, request inter
 	return nil
 }
 
-// decodeGetResponse is a transport/http.DecodeResponseFunc that decodes
+// decodeLoginResponse is a transport/http.DecodeResponseFunc that decodes
 // a JSON-encoded concat response from the HTTP response body. If the response
 // as a non-200 status code, we will interpret that as an error and attempt to
 //  decode the specific error message from the response body.
-func decodeGetResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+func decodeLoginResponse(_ context.Context, r *http1.Response) (interface{}, error) {
 	if r.StatusCode != http1.StatusOK {
 		return nil, http2.ErrorDecoder(r)
 	}
-	var resp endpoint1.GetResponse
+	var resp endpoint1.LoginResponse
 	err := json.NewDecoder(r.Body).Decode(&resp)
 	return resp, err
 }
 
-// decodeLoginResponse is a transport/http.DecodeResponseFunc that decodes
+// decodeRegisterResponse is a transport/http.DecodeResponseFunc that decodes
 // a JSON-encoded concat response from the HTTP response body. If the response
 // as a non-200 status code, we will interpret that as an error and attempt to
 //  decode the specific error message from the response body.
-func decodeLoginResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+func decodeRegisterResponse(_ context.Context, r *http1.Response) (interface{}, error) {
 	if r.StatusCode != http1.StatusOK {
 		return nil, http2.ErrorDecoder(r)
 	}
-	var resp endpoint1.LoginResponse
+	var resp endpoint1.RegisterResponse
 	err := json.NewDecoder(r.Body).Decode(&resp)
 	return resp, err
 }
 
-// decodeRegisterResponse is a transport/http.DecodeResponseFunc that decodes
+// decodeRequestVerificationResponse is a transport/http.DecodeResponseFunc that decodes
 // a JSON-encoded concat response from the HTTP response body. If the response
 // as a non-200 status code, we will interpret that as an error and attempt to
 //  decode the specific error message from the response body.
-func decodeRegisterResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+func decodeRequestVerificationResponse(_ context.Context, r *http1.Response) (interface{}, error) {
 	if r.StatusCode != http1.StatusOK {
 		return nil, http2.ErrorDecoder(r)
 	}
-	var resp endpoint1.RegisterResponse
+	var resp endpoint1.RequestVerificationResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeConfirmVerificationResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeConfirmVerificationResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ConfirmVerificationResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeRequestPasswordResetResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeRequestPasswordResetResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.RequestPasswordResetResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeResetPasswordResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeResetPasswordResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ResetPasswordResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeUpdateProfileResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeUpdateProfileResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.UpdateProfileResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeChangePasswordResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeChangePasswordResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ChangePasswordResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeEnrollTOTPResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeEnrollTOTPResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.EnrollTOTPResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeConfirmTOTPResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeConfirmTOTPResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ConfirmTOTPResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeVerifySecondFactorResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeVerifySecondFactorResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.VerifySecondFactorResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeListSessionsResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeListSessionsResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ListSessionsResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeRevokeSessionResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeRevokeSessionResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.RevokeSessionResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeOIDCLoginResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeOIDCLoginResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.OIDCLoginResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeOIDCCallbackResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeOIDCCallbackResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.OIDCCallbackResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeCreateAPIKeyResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeCreateAPIKeyResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.CreateAPIKeyResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeListAPIKeysResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeListAPIKeysResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ListAPIKeysResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeRevokeAPIKeyResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeRevokeAPIKeyResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.RevokeAPIKeyResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeDeleteAccountResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeDeleteAccountResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.DeleteAccountResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeExportMyDataResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeExportMyDataResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ExportMyDataResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeExportStatusResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeExportStatusResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ExportStatusResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeGetPublicProfileResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeGetPublicProfileResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.GetPublicProfileResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeUpdatePublicProfileResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeUpdatePublicProfileResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.UpdatePublicProfileResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeFollowResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeFollowResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.FollowResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeUnfollowResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeUnfollowResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.UnfollowResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeListFollowersResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeListFollowersResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ListFollowersResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeListFollowingResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeListFollowingResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.ListFollowingResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+// decodeCancelDeletionResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded concat response from the HTTP response body. If the response
+// as a non-200 status code, we will interpret that as an error and attempt to
+//  decode the specific error message from the response body.
+func decodeCancelDeletionResponse(_ context.Context, r *http1.Response) (interface{}, error) {
+	if r.StatusCode != http1.StatusOK {
+		return nil, http2.ErrorDecoder(r)
+	}
+	var resp endpoint1.CancelDeletionResponse
 	err := json.NewDecoder(r.Body).Decode(&resp)
 	return resp, err
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io"
@@ -9,9 +10,11 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	endpoint1 "github.com/go-kit/kit/endpoint"
 	log "github.com/go-kit/kit/log"
+	grpc2 "github.com/go-kit/kit/transport/grpc"
 	http1 "github.com/go-kit/kit/transport/http"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/hashicorp/vault/api"
@@ -26,10 +29,15 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/emadghaffari/kit-blog/users/config"
+	"github.com/emadghaffari/kit-blog/users/pkg/auth"
+	"github.com/emadghaffari/kit-blog/users/pkg/device"
 	endpoint "github.com/emadghaffari/kit-blog/users/pkg/endpoint"
+	"github.com/emadghaffari/kit-blog/users/pkg/export"
 	grpc "github.com/emadghaffari/kit-blog/users/pkg/grpc"
 	pb "github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
 	pkghttp "github.com/emadghaffari/kit-blog/users/pkg/http"
+	"github.com/emadghaffari/kit-blog/users/pkg/keys"
+	"github.com/emadghaffari/kit-blog/users/pkg/model"
 	"github.com/emadghaffari/kit-blog/users/pkg/redis"
 	service "github.com/emadghaffari/kit-blog/users/pkg/service"
 )
@@ -50,6 +58,18 @@ var thriftFramed = fs.Bool("thrift-framed", false, "true to enable framing")
 var zipkinURL = fs.String("zipkin-url", "", "Enable Zipkin tracing via a collector URL e.g. http://localhost:9411/api/v1/spans")
 var lightstepToken = fs.String("lightstep-token", "", "Enable LightStep tracing via a LightStep access token")
 var appdashAddr = fs.String("appdash-addr", "", "Enable Appdash tracing via an Appdash server host:port")
+var generateKey = fs.Bool("jwt-generate-key", false, "generate a jwt signing key in vault when there is none, only for a single instance")
+var keysRefresh = fs.Duration("jwt-keys-refresh", 5*time.Minute, "how often the jwt signing keys are reloaded from vault")
+var jwtIssuer = fs.String("jwt-issuer", "kit-blog/users", "iss claim of the access tokens")
+var jwtAudience = fs.String("jwt-audience", "kit-blog", "aud claim of the access tokens")
+var jwtSkew = fs.Duration("jwt-clock-skew", 30*time.Second, "clock difference tolerated when checking access tokens")
+var exportTTL = fs.Duration("export-ttl", 24*time.Hour, "how long a data export can be downloaded")
+var deleteGrace = fs.Duration("delete-grace", 30*24*time.Hour, "how long a deleted account is kept before it is removed")
+var oidcIssuer = fs.String("oidc-issuer", "", "Enable OIDC login via the issuer URL of an OpenID provider")
+var oidcClientID = fs.String("oidc-client-id", "", "client id registered at the OpenID provider")
+var oidcClientSecret = fs.String("oidc-client-secret", "", "client secret registered at the OpenID provider")
+var trustedProxies = fs.String("trusted-proxies", "", "comma separated CIDRs of the proxies whose X-Forwarded-For header is trusted")
+var oidcRedirectURL = fs.String("oidc-redirect-url", "http://localhost:1381/oidc/callback", "callback URL registered at the OpenID provider")
 
 // Run func
 func Run() {
@@ -98,10 +118,11 @@ func Run() {
 		logger.Log("during", "Listen", "vault", "err", err)
 	}
 	svc := service.New(getServiceMiddleware(logger))
-	eps := endpoint.New(svc, getEndpointMiddleware(logger))
+	eps := endpoint.New(svc, getEndpointMiddleware(logger, svc))
 	g := createService(eps)
 	initMetricsEndpoint(g)
 	initCancelInterrupt(g)
+	initKeyRotation(g)
 	initRedis()
 
 	logger.Log("exit", g.Run())
@@ -110,6 +131,9 @@ func Run() {
 func initGRPCHandler(endpoints endpoint.Endpoints, g *group.Group) {
 	options := defaultGRPCOptions(logger, tracer)
 	// Add your GRPC options here
+	for m := range options {
+		options[m] = append(options[m], grpc2.ServerBefore(auth.GRPCToContext(), device.GRPCToContext()))
+	}
 
 	grpcServer := grpc.NewGRPCServer(endpoints, options)
 	grpcListener, err := net.Listen("tcp", *grpcAddr)
@@ -137,7 +161,7 @@ func getServiceMiddleware(logger log.Logger) (mw []service.Middleware) {
 
 	return
 }
-func getEndpointMiddleware(logger log.Logger) (mw map[string][]endpoint1.Middleware) {
+func getEndpointMiddleware(logger log.Logger, svc service.UsersService) (mw map[string][]endpoint1.Middleware) {
 	mw = map[string][]endpoint1.Middleware{}
 	// duration := prometheus.NewSummaryFrom(prometheus1.SummaryOpts{
 	// 	Help:      "Request duration in seconds.",
@@ -149,8 +173,35 @@ func getEndpointMiddleware(logger log.Logger) (mw map[string][]endpoint1.Middlew
 	// Add you endpoint middleware here
 	addEndpointMiddlewareToAllMethods(mw, endpoint1.Middleware(func(e endpoint1.Endpoint) endpoint1.Endpoint { return e }))
 
+	local := localAuth(svc)
+	for _, m := range []string{"RequestVerification", "ConfirmVerification", "UpdateProfile", "UpdatePublicProfile", "Follow", "Unfollow"} {
+		mw[m] = append(mw[m], auth.Middleware(local, auth.Scoped(auth.ScopeProfileWrite)))
+	}
+	// API keys never manage the account or other keys
+	for _, m := range []string{"ChangePassword", "EnrollTOTP", "ConfirmTOTP", "ListSessions", "RevokeSession", "CreateAPIKey", "ListAPIKeys", "RevokeAPIKey", "DeleteAccount", "CancelDeletion", "ExportMyData", "ExportStatus"} {
+		mw[m] = append(mw[m], auth.Middleware(local, auth.Interactive()))
+	}
+	for _, m := range []string{"GrantRole", "RevokeRole"} {
+		mw[m] = append(mw[m], auth.Middleware(local, auth.All(auth.Interactive(), auth.AnyRole(auth.RoleAdmin))))
+	}
+	// contact data is for the blog services sending notifications, and admins
+	mw["GetPrivate"] = append(mw["GetPrivate"], auth.Middleware(auth.WithService(local, config.Confs.Service.Key), auth.AnyRole(auth.RoleService)))
+
 	return
 }
+
+// localAuth returns an Authenticator on s, the users service checks its own
+// tokens and keys while other services ask Authenticate.
+func localAuth(s service.UsersService) auth.Authenticator {
+	return auth.AuthenticatorFunc(func(ctx context.Context, token string) (auth.Identity, error) {
+		id, username, roles, scopes, keyID, err := s.Authenticate(ctx, token)
+		if err != nil {
+			return auth.Identity{}, err
+		}
+		return auth.Identity{UserID: id, Username: username, Roles: roles, KeyID: keyID, Scopes: scopes}, nil
+	})
+}
+
 func initMetricsEndpoint(g *group.Group) {
 	http.DefaultServeMux.Handle("/metrics", promhttp.Handler())
 	debugListener, err := net.Listen("tcp", *debugAddr)
@@ -182,7 +233,19 @@ func initCancelInterrupt(g *group.Group) {
 
 // initHTTPpHandler func
 func initHTTPpHandler(endpoints endpoint.Endpoints, g *group.Group) {
-	httpHandler := pkghttp.NewHTTPHandler(endpoints, map[string][]http1.ServerOption{})
+	proxies, err := device.ParseProxies(*trustedProxies)
+	if err != nil {
+		logger.Log("transport", "HTTP", "during", "ParseProxies", "err", err)
+	}
+	options := defaultHTTPOptions(logger, tracer)
+	for m := range options {
+		options[m] = append(options[m], http1.ServerBefore(auth.HTTPToContext(), device.HTTPToContext(proxies)))
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/", pkghttp.NewHTTPHandler(endpoints, options))
+	mux.Handle("/.well-known/jwks.json", keys.Handler(model.Keys))
+	mux.Handle(export.DownloadPath, export.Handler(export.New(config.Confs.Export.TTL), localAuth(endpoints)))
+	httpHandler := mux
 	httpListener, err := net.Listen("tcp", *httpAddr)
 	if err != nil {
 		logger.Log("transport", "HTTP", "during", "Listen", "err", err)
@@ -202,7 +265,20 @@ func initVault() error {
 	config.Confs.Users.Host = "localhost"
 	config.Confs.Users.Path = "blog/users"
 	config.Confs.JWT.Path = "blog/jwt/secret"
+	config.Confs.JWT.KeysPath = "blog/jwt/keys"
+	config.Confs.JWT.Issuer = *jwtIssuer
+	config.Confs.JWT.Audience = *jwtAudience
+	config.Confs.JWT.Skew = *jwtSkew
+	config.Confs.Posts.Path = "blog/posts"
+	config.Confs.Comments.Path = "blog/comments"
+	config.Confs.Export.TTL = *exportTTL
+	config.Confs.Deletion.Grace = *deleteGrace
+	config.Confs.OIDC.Issuer = *oidcIssuer
+	config.Confs.OIDC.ClientID = *oidcClientID
+	config.Confs.OIDC.ClientSecret = *oidcClientSecret
+	config.Confs.OIDC.RedirectURL = *oidcRedirectURL
 	config.Confs.Redis.Path = "blog/redis"
+	config.Confs.Service.Path = "blog/service"
 	config.Confs.Users.DebugAddr = *debugAddr
 	config.Confs.Users.HTTPAddr = *httpAddr
 	config.Confs.Users.GrpcAddr = *grpcAddr
@@ -237,6 +313,12 @@ func initVault() error {
 	config.Confs.JWT.Secret = jwt.Data["jwt"].(string)
 	config.Confs.JWT.RSecret = jwt.Data["rjwt"].(string)
 
+	// Read jwt signing keys
+	if err := loadKeys(c, *generateKey); err != nil {
+		logger.Log("during", "loadKeys", "err", err)
+		return err
+	}
+
 	// Read jwt secret
 	rd, err := c.Read(config.Confs.Redis.Path)
 	if err != nil {
@@ -246,12 +328,27 @@ func initVault() error {
 	config.Confs.Redis.Host = rd.Data["host"].(string)
 	config.Confs.Redis.DB = rd.Data["db"].(string)
 
+	// Read the service key, users sends notifications with it
+	svc, err := c.Read(config.Confs.Service.Path)
+	if err != nil {
+		logger.Log(err)
+		return err
+	}
+	if svc == nil {
+		return fmt.Errorf("no service key at %s", config.Confs.Service.Path)
+	}
+	config.Confs.Service.Key, _ = svc.Data["key"].(string)
+
 	// Write users Path
 	_, err = c.Write(config.Confs.Users.Path, map[string]interface{}{
 		"debug":  config.Confs.Users.Host + config.Confs.Users.DebugAddr,
 		"http":   config.Confs.Users.Host + config.Confs.Users.HTTPAddr,
 		"grpc":   config.Confs.Users.Host + config.Confs.Users.GrpcAddr,
 		"thrift": config.Confs.Users.Host + config.Confs.Users.ThriftAddr,
+		// the other services check access tokens with these
+		"jwks":     "http://" + config.Confs.Users.Host + config.Confs.Users.HTTPAddr + "/.well-known/jwks.json",
+		"issuer":   config.Confs.JWT.Issuer,
+		"audience": config.Confs.JWT.Audience,
 	})
 	if err != nil {
 		logger.Log(err)
@@ -265,3 +362,69 @@ func initRedis() {
 	redis.DB.New()
 
 }
+
+// loadKeys reads the signing keys from vault into model.Keys. Without keys
+// it fails unless generate is set: vault has no check-and-set on this path,
+// so two instances generating at once would each sign with a key the other
+// overwrote.
+func loadKeys(c *api.Logical, generate bool) error {
+	secret, err := c.Read(config.Confs.JWT.KeysPath)
+	if err != nil {
+		return err
+	}
+
+	pems := map[string]string{}
+	active := ""
+	if secret != nil {
+		for k, v := range secret.Data {
+			if s, ok := v.(string); ok && k == "active" {
+				active = s
+			} else if ok {
+				pems[k] = s
+			}
+		}
+	}
+
+	if len(pems) == 0 && !generate {
+		return fmt.Errorf("no jwt signing keys at %s", config.Confs.JWT.KeysPath)
+	}
+	if len(pems) == 0 {
+		kid, key, err := keys.Generate()
+		if err != nil {
+			return err
+		}
+		active, pems[kid] = kid, keys.EncodePEM(key)
+		if _, err := c.Write(config.Confs.JWT.KeysPath, map[string]interface{}{"active": kid, kid: pems[kid]}); err != nil {
+			return err
+		}
+		logger.Log("jwt", "generated signing key", "kid", kid)
+	}
+
+	set, err := keys.ParsePEM(pems)
+	if err != nil {
+		return err
+	}
+	return model.Keys.Replace(set, active)
+}
+
+// initKeyRotation reloads the signing keys, so a key added or activated in
+// vault is used without a restart.
+func initKeyRotation(g *group.Group) {
+	done := make(chan struct{})
+	g.Add(func() error {
+		t := time.NewTicker(*keysRefresh)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := loadKeys(config.Confs.Vault.Logical, false); err != nil {
+					logger.Log("during", "loadKeys", "err", err)
+				}
+			case <-done:
+				return nil
+			}
+		}
+	}, func(error) {
+		close(done)
+	})
+}
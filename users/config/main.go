@@ -1,6 +1,10 @@
 package config
 
-import "github.com/hashicorp/vault/api"
+import (
+	"time"
+
+	"github.com/hashicorp/vault/api"
+)
 
 // Confs var
 var Confs configs
@@ -33,6 +37,40 @@ type (
 			Secret  string
 			RSecret string
 			Path    string
+			// KeysPath holds the RS256 signing keys by kid, and the kid
+			// of the active one under "active"
+			KeysPath string
+			// Issuer and Audience are set in and required from access tokens
+			Issuer   string
+			Audience string
+			// Skew is the clock difference tolerated when checking tokens
+			Skew time.Duration
+		}
+		Posts struct {
+			Path string
+		}
+		Comments struct {
+			Path string
+		}
+		Export struct {
+			// TTL is how long an export job and its archive are kept
+			TTL time.Duration
+		}
+		Deletion struct {
+			// Grace is how long a deleted account is kept before it is removed
+			Grace time.Duration
+		}
+		OIDC struct {
+			Issuer       string
+			ClientID     string
+			ClientSecret string
+			RedirectURL  string
+		}
+		Service struct {
+			Path string
+			// Key is shared by the blog services to call the methods
+			// users may not, like GetPrivate
+			Key string
 		}
 		Redis struct {
 			Path     string
@@ -0,0 +1,110 @@
+// Package auth is shared by the blog services to authenticate callers with
+// the users service and authorize them by role.
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Roles a user can be granted. Every signed in user may write posts and
+// comments and manage their own; roles grant power over other users' data.
+const (
+	RoleAdmin     = "admin"
+	RoleEditor    = "editor"
+	RoleModerator = "moderator"
+)
+
+// Roles lists the valid roles.
+var Roles = map[string]bool{
+	RoleAdmin:     true,
+	RoleEditor:    true,
+	RoleModerator: true,
+}
+
+// Scopes an API key can be limited to. Signed in users are not limited.
+const (
+	ScopeProfileWrite  = "profile:write"
+	ScopePostsWrite    = "posts:write"
+	ScopeCommentsWrite = "comments:write"
+)
+
+// Scopes lists the valid scopes.
+var Scopes = map[string]bool{
+	ScopeProfileWrite:  true,
+	ScopePostsWrite:    true,
+	ScopeCommentsWrite: true,
+}
+
+var (
+	// ErrUnauthenticated is returned when a method needs a signed in caller.
+	ErrUnauthenticated = status.Error(codes.Unauthenticated, "authentication required")
+	// ErrForbidden is returned when the caller lacks the permission.
+	ErrForbidden = status.Error(codes.PermissionDenied, "permission denied")
+)
+
+// Identity is the authenticated caller.
+type Identity struct {
+	UserID   string
+	Username string
+	Roles    []string
+	// KeyID is the API key the caller used, empty for a signed in user
+	KeyID  string
+	Scopes []string
+}
+
+// Has reports whether the identity has any of roles. Admins have every role.
+func (i Identity) Has(roles ...string) bool {
+	for _, r := range i.Roles {
+		if r == RoleAdmin {
+			return true
+		}
+		for _, want := range roles {
+			if r == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Can reports whether the identity may act within scope. Only API keys are
+// limited by scopes.
+func (i Identity) Can(scope string) bool {
+	if i.KeyID == "" {
+		return true
+	}
+	for _, s := range i.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type identityKey struct{}
+
+// NewContext returns ctx carrying the identity.
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity put in ctx by Middleware.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
+
+// Allow checks that the caller owns the resource of ownerID or has one of roles.
+func Allow(ctx context.Context, ownerID string, roles ...string) error {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if (ownerID != "" && id.UserID == ownerID) || id.Has(roles...) {
+		return nil
+	}
+	return ErrForbidden
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
+	"github.com/emadghaffari/kit-blog/users/pkg/tokens"
+)
+
+// Authenticator resolves a token to the identity of its user.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (Identity, error)
+}
+
+// AuthenticatorFunc adapts a function to Authenticator.
+type AuthenticatorFunc func(ctx context.Context, token string) (Identity, error)
+
+// Authenticate implements Authenticator.
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, token string) (Identity, error) {
+	return f(ctx, token)
+}
+
+// NewClient returns an Authenticator asking the users service, which also
+// rejects tokens of ended sessions.
+func NewClient(client pb.UsersClient) Authenticator {
+	return AuthenticatorFunc(func(ctx context.Context, token string) (Identity, error) {
+		res, err := client.Authenticate(ctx, &pb.AuthenticateRequest{Token: token})
+		if err != nil {
+			return Identity{}, ErrUnauthenticated
+		}
+		return Identity{UserID: res.Id, Username: res.Username, Roles: res.Roles, KeyID: res.KeyID, Scopes: res.Scopes}, nil
+	})
+}
+
+// NewLocal returns an Authenticator that checks tokens with p, e.g. with
+// the keys of a keys.Remote reading the JWKS of the users service. It saves
+// a call per request, but a revoked session stays valid until its token
+// expires. API keys are only known to the users service and never pass.
+func NewLocal(p *tokens.Parser) Authenticator {
+	return AuthenticatorFunc(func(ctx context.Context, token string) (Identity, error) {
+		claims, err := p.Parse(token)
+		if err != nil || claims.Subject == "" {
+			return Identity{}, ErrUnauthenticated
+		}
+		return Identity{UserID: claims.Subject, Username: claims.Username, Roles: claims.Roles}, nil
+	})
+}
+
+// WithLocal returns an Authenticator that checks access tokens with local
+// and hands API keys, which only the users service can look up, to remote.
+func WithLocal(local, remote Authenticator) Authenticator {
+	return AuthenticatorFunc(func(ctx context.Context, token string) (Identity, error) {
+		if IsAPIKey(token) {
+			return remote.Authenticate(ctx, token)
+		}
+		return local.Authenticate(ctx, token)
+	})
+}
+
+// Policy decides whether an identity may call a method.
+type Policy func(Identity) error
+
+// Authenticated lets every signed in user through.
+func Authenticated() Policy {
+	return func(Identity) error { return nil }
+}
+
+// AnyRole lets through users with one of roles.
+func AnyRole(roles ...string) Policy {
+	return func(id Identity) error {
+		if id.Has(roles...) {
+			return nil
+		}
+		return ErrForbidden
+	}
+}
+
+// Scoped lets through signed in users and API keys with scope.
+func Scoped(scope string) Policy {
+	return func(id Identity) error {
+		if id.Can(scope) {
+			return nil
+		}
+		return ErrForbidden
+	}
+}
+
+// Interactive lets through signed in users but no API keys, for methods
+// that manage the account itself.
+func Interactive() Policy {
+	return func(id Identity) error {
+		if id.KeyID != "" {
+			return ErrForbidden
+		}
+		return nil
+	}
+}
+
+// All lets through identities that pass every one of policies.
+func All(policies ...Policy) Policy {
+	return func(id Identity) error {
+		for _, p := range policies {
+			if err := p(id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Middleware authenticates the bearer token or else the API key of the
+// request, checks policy and puts the identity in the context for the
+// service to check ownership. Keys are told from tokens by their prefix.
+func Middleware(a Authenticator, policy Policy) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			token := TokenFromContext(ctx)
+			if token == "" {
+				token = APIKeyFromContext(ctx)
+			}
+			if token == "" {
+				return nil, ErrUnauthenticated
+			}
+			id, err := a.Authenticate(ctx, token)
+			if err != nil {
+				return nil, ErrUnauthenticated
+			}
+			if err := policy(id); err != nil {
+				return nil, err
+			}
+			return next(NewContext(ctx, id), request)
+		}
+	}
+}
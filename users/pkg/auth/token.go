@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	stdhttp "net/http"
+	"strings"
+
+	"github.com/go-kit/kit/transport/grpc"
+	"github.com/go-kit/kit/transport/http"
+	"google.golang.org/grpc/metadata"
+)
+
+// APIKeyPrefix starts every API key, so they are told apart from access
+// tokens and found by secret scanners.
+const APIKeyPrefix = "kb_"
+
+// IsAPIKey reports whether token is an API key rather than an access token.
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+type tokenKey struct{}
+
+type apiKeyKey struct{}
+
+// TokenFromContext returns the bearer token put in ctx by the transport.
+func TokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(tokenKey{}).(string)
+	return token
+}
+
+// APIKeyFromContext returns the API key put in ctx by the transport.
+func APIKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(apiKeyKey{}).(string)
+	return key
+}
+
+// GRPCToContext moves the "authorization" and "x-api-key" metadata into the
+// context.
+func GRPCToContext() grpc.ServerRequestFunc {
+	return func(ctx context.Context, md metadata.MD) context.Context {
+		if v := md.Get("authorization"); len(v) > 0 {
+			ctx = context.WithValue(ctx, tokenKey{}, bearer(v[0]))
+		}
+		if v := md.Get("x-api-key"); len(v) > 0 {
+			ctx = context.WithValue(ctx, apiKeyKey{}, v[0])
+		}
+		return ctx
+	}
+}
+
+// HTTPToContext moves the Authorization and X-API-Key headers into the
+// context.
+func HTTPToContext() http.RequestFunc {
+	return func(ctx context.Context, r *stdhttp.Request) context.Context {
+		if v := r.Header.Get("Authorization"); v != "" {
+			ctx = context.WithValue(ctx, tokenKey{}, bearer(v))
+		}
+		if v := r.Header.Get("X-API-Key"); v != "" {
+			ctx = context.WithValue(ctx, apiKeyKey{}, v)
+		}
+		return ctx
+	}
+}
+
+func bearer(v string) string {
+	if len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+		return v[7:]
+	}
+	return v
+}
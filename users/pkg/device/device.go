@@ -0,0 +1,119 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"net"
+	stdhttp "net/http"
+	"strings"
+
+	"github.com/go-kit/kit/transport/grpc"
+	"github.com/go-kit/kit/transport/http"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Info describes the client that sent a request.
+type Info struct {
+	IP        string
+	UserAgent string
+}
+
+type infoKey struct{}
+
+// FromContext returns the client of the request in ctx. Without transport
+// metadata the IP of a grpc peer is used.
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(infoKey{}).(Info)
+	if info.IP == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			info.IP = host(p.Addr.String())
+		}
+	}
+	return info
+}
+
+// ParseProxies parses a comma separated list of CIDRs, a bare IP is
+// taken as a single address.
+func ParseProxies(list string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, v := range strings.Split(list, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if !strings.Contains(v, "/") {
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return nil, fmt.Errorf("device: invalid proxy address %q", v)
+			}
+			bits := 8 * len(ip.To4())
+			if bits == 0 {
+				bits = 8 * net.IPv6len
+			}
+			v = fmt.Sprintf("%s/%d", v, bits)
+		}
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("device: invalid proxy range %q: %v", v, err)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+// HTTPToContext puts the client of the request in the context. The
+// X-Forwarded-For header is only read when the request comes from one of
+// the trusted proxies, the client is then the right-most hop that is not a
+// trusted proxy itself, as every hop left of it could be set by the client.
+func HTTPToContext(trusted []*net.IPNet) http.RequestFunc {
+	return func(ctx context.Context, r *stdhttp.Request) context.Context {
+		info := Info{IP: host(r.RemoteAddr), UserAgent: r.UserAgent()}
+		if contains(trusted, info.IP) {
+			hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+			for i := len(hops) - 1; i >= 0; i-- {
+				hop := strings.TrimSpace(hops[i])
+				if net.ParseIP(hop) == nil {
+					break
+				}
+				info.IP = hop
+				if !contains(trusted, hop) {
+					break
+				}
+			}
+		}
+		return context.WithValue(ctx, infoKey{}, info)
+	}
+}
+
+// GRPCToContext puts the user agent of the request in the context, the IP
+// is read from the grpc peer.
+func GRPCToContext() grpc.ServerRequestFunc {
+	return func(ctx context.Context, md metadata.MD) context.Context {
+		info := Info{}
+		if v := md.Get("user-agent"); len(v) > 0 {
+			info.UserAgent = v[0]
+		}
+		return context.WithValue(ctx, infoKey{}, info)
+	}
+}
+
+func contains(nets []*net.IPNet, addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func host(addr string) string {
+	if h, _, err := net.SplitHostPort(addr); err == nil {
+		return h
+	}
+	return addr
+}
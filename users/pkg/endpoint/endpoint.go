@@ -5,6 +5,7 @@ import (
 
 	endpoint "github.com/go-kit/kit/endpoint"
 
+	"github.com/emadghaffari/kit-blog/users/pkg/model"
 	service "github.com/emadghaffari/kit-blog/users/pkg/service"
 )
 
@@ -16,18 +17,20 @@ type LoginRequest struct {
 
 // LoginResponse collects the response parameters for the Login method.
 type LoginResponse struct {
-	S0 string `json:"s0"`
-	E1 error  `json:"e1"`
+	S0        string `json:"s0"`
+	Challenge string `json:"challenge,omitempty"`
+	E1        error  `json:"e1"`
 }
 
 // MakeLoginEndpoint returns an endpoint that invokes Login on the service.
 func MakeLoginEndpoint(s service.UsersService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(LoginRequest)
-		s0, e1 := s.Login(ctx, req.Username, req.Password)
+		s0, challenge, e1 := s.Login(ctx, req.Username, req.Password)
 		return LoginResponse{
-			E1: e1,
-			S0: s0,
+			Challenge: challenge,
+			E1:        e1,
+			S0:        s0,
 		}, nil
 	}
 }
@@ -68,6 +71,886 @@ func (r RegisterResponse) Failed() error {
 	return r.E1
 }
 
+// RequestVerificationRequest collects the request parameters for the RequestVerification method.
+type RequestVerificationRequest struct {
+	Id      string `json:"id"`
+	Channel string `json:"channel"`
+}
+
+// RequestVerificationResponse collects the response parameters for the RequestVerification method.
+type RequestVerificationResponse struct {
+	ExpiresIn int64 `json:"expires_in"`
+	Err       error `json:"err"`
+}
+
+// MakeRequestVerificationEndpoint returns an endpoint that invokes RequestVerification on the service.
+func MakeRequestVerificationEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RequestVerificationRequest)
+		expiresIn, err := s.RequestVerification(ctx, req.Id, req.Channel)
+		return RequestVerificationResponse{
+			ExpiresIn: expiresIn,
+			Err:       err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r RequestVerificationResponse) Failed() error {
+	return r.Err
+}
+
+// ConfirmVerificationRequest collects the request parameters for the ConfirmVerification method.
+type ConfirmVerificationRequest struct {
+	Id      string `json:"id"`
+	Channel string `json:"channel"`
+	Code    string `json:"code"`
+}
+
+// ConfirmVerificationResponse collects the response parameters for the ConfirmVerification method.
+type ConfirmVerificationResponse struct {
+	Verified bool  `json:"verified"`
+	Err      error `json:"err"`
+}
+
+// MakeConfirmVerificationEndpoint returns an endpoint that invokes ConfirmVerification on the service.
+func MakeConfirmVerificationEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ConfirmVerificationRequest)
+		verified, err := s.ConfirmVerification(ctx, req.Id, req.Channel, req.Code)
+		return ConfirmVerificationResponse{
+			Verified: verified,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ConfirmVerificationResponse) Failed() error {
+	return r.Err
+}
+
+// AuthenticateRequest collects the request parameters for the Authenticate method.
+type AuthenticateRequest struct {
+	Token string `json:"token"`
+}
+
+// AuthenticateResponse collects the response parameters for the Authenticate method.
+type AuthenticateResponse struct {
+	Id       string   `json:"id"`
+	Username string   `json:"username"`
+	Roles    []string `json:"roles"`
+	Scopes   []string `json:"scopes"`
+	KeyID    string   `json:"keyID"`
+	Err      error    `json:"err"`
+}
+
+// MakeAuthenticateEndpoint returns an endpoint that invokes Authenticate on the service.
+func MakeAuthenticateEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(AuthenticateRequest)
+		id, username, roles, scopes, keyID, err := s.Authenticate(ctx, req.Token)
+		return AuthenticateResponse{
+			Id:       id,
+			Username: username,
+			Roles:    roles,
+			Scopes:   scopes,
+			KeyID:    keyID,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r AuthenticateResponse) Failed() error {
+	return r.Err
+}
+
+// RequestPasswordResetRequest collects the request parameters for the RequestPasswordReset method.
+type RequestPasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+// RequestPasswordResetResponse collects the response parameters for the RequestPasswordReset method.
+type RequestPasswordResetResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeRequestPasswordResetEndpoint returns an endpoint that invokes RequestPasswordReset on the service.
+func MakeRequestPasswordResetEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RequestPasswordResetRequest)
+		response, err := s.RequestPasswordReset(ctx, req.Email)
+		return RequestPasswordResetResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r RequestPasswordResetResponse) Failed() error {
+	return r.Err
+}
+
+// ResetPasswordRequest collects the request parameters for the ResetPassword method.
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// ResetPasswordResponse collects the response parameters for the ResetPassword method.
+type ResetPasswordResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeResetPasswordEndpoint returns an endpoint that invokes ResetPassword on the service.
+func MakeResetPasswordEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ResetPasswordRequest)
+		response, err := s.ResetPassword(ctx, req.Token, req.Password)
+		return ResetPasswordResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ResetPasswordResponse) Failed() error {
+	return r.Err
+}
+
+// UpdateProfileRequest collects the request parameters for the UpdateProfile method.
+type UpdateProfileRequest struct {
+	Id       string `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Phone    string `json:"phone"`
+}
+
+// UpdateProfileResponse collects the response parameters for the UpdateProfile method.
+type UpdateProfileResponse struct {
+	NewUsername string `json:"new_username"`
+	NewEmail    string `json:"new_email"`
+	NewPhone    string `json:"new_phone"`
+	Err         error  `json:"err"`
+}
+
+// MakeUpdateProfileEndpoint returns an endpoint that invokes UpdateProfile on the service.
+func MakeUpdateProfileEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateProfileRequest)
+		newUsername, newEmail, newPhone, err := s.UpdateProfile(ctx, req.Id, req.Username, req.Email, req.Phone)
+		return UpdateProfileResponse{
+			NewUsername: newUsername,
+			NewEmail:    newEmail,
+			NewPhone:    newPhone,
+			Err:         err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r UpdateProfileResponse) Failed() error {
+	return r.Err
+}
+
+// ChangePasswordRequest collects the request parameters for the ChangePassword method.
+type ChangePasswordRequest struct {
+	Id       string `json:"id"`
+	Current  string `json:"current"`
+	Password string `json:"password"`
+}
+
+// ChangePasswordResponse collects the response parameters for the ChangePassword method.
+type ChangePasswordResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeChangePasswordEndpoint returns an endpoint that invokes ChangePassword on the service.
+func MakeChangePasswordEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ChangePasswordRequest)
+		response, err := s.ChangePassword(ctx, req.Id, req.Current, req.Password)
+		return ChangePasswordResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ChangePasswordResponse) Failed() error {
+	return r.Err
+}
+
+// GrantRoleRequest collects the request parameters for the GrantRole method.
+type GrantRoleRequest struct {
+	Id   string `json:"id"`
+	Role string `json:"role"`
+}
+
+// GrantRoleResponse collects the response parameters for the GrantRole method.
+type GrantRoleResponse struct {
+	Roles []string `json:"roles"`
+	Err   error    `json:"err"`
+}
+
+// MakeGrantRoleEndpoint returns an endpoint that invokes GrantRole on the service.
+func MakeGrantRoleEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GrantRoleRequest)
+		roles, err := s.GrantRole(ctx, req.Id, req.Role)
+		return GrantRoleResponse{
+			Roles: roles,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r GrantRoleResponse) Failed() error {
+	return r.Err
+}
+
+// RevokeRoleRequest collects the request parameters for the RevokeRole method.
+type RevokeRoleRequest struct {
+	Id   string `json:"id"`
+	Role string `json:"role"`
+}
+
+// RevokeRoleResponse collects the response parameters for the RevokeRole method.
+type RevokeRoleResponse struct {
+	Roles []string `json:"roles"`
+	Err   error    `json:"err"`
+}
+
+// MakeRevokeRoleEndpoint returns an endpoint that invokes RevokeRole on the service.
+func MakeRevokeRoleEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RevokeRoleRequest)
+		roles, err := s.RevokeRole(ctx, req.Id, req.Role)
+		return RevokeRoleResponse{
+			Roles: roles,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r RevokeRoleResponse) Failed() error {
+	return r.Err
+}
+
+// EnrollTOTPRequest collects the request parameters for the EnrollTOTP method.
+type EnrollTOTPRequest struct {
+	Id string `json:"id"`
+}
+
+// EnrollTOTPResponse collects the response parameters for the EnrollTOTP method.
+type EnrollTOTPResponse struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+	Err    error  `json:"err"`
+}
+
+// MakeEnrollTOTPEndpoint returns an endpoint that invokes EnrollTOTP on the service.
+func MakeEnrollTOTPEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(EnrollTOTPRequest)
+		secret, uri, err := s.EnrollTOTP(ctx, req.Id)
+		return EnrollTOTPResponse{
+			Secret: secret,
+			Uri:    uri,
+			Err:    err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r EnrollTOTPResponse) Failed() error {
+	return r.Err
+}
+
+// ConfirmTOTPRequest collects the request parameters for the ConfirmTOTP method.
+type ConfirmTOTPRequest struct {
+	Id   string `json:"id"`
+	Code string `json:"code"`
+}
+
+// ConfirmTOTPResponse collects the response parameters for the ConfirmTOTP method.
+type ConfirmTOTPResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+	Err           error    `json:"err"`
+}
+
+// MakeConfirmTOTPEndpoint returns an endpoint that invokes ConfirmTOTP on the service.
+func MakeConfirmTOTPEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ConfirmTOTPRequest)
+		recoveryCodes, err := s.ConfirmTOTP(ctx, req.Id, req.Code)
+		return ConfirmTOTPResponse{
+			RecoveryCodes: recoveryCodes,
+			Err:           err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ConfirmTOTPResponse) Failed() error {
+	return r.Err
+}
+
+// VerifySecondFactorRequest collects the request parameters for the VerifySecondFactor method.
+type VerifySecondFactorRequest struct {
+	Challenge string `json:"challenge"`
+	Code      string `json:"code"`
+}
+
+// VerifySecondFactorResponse collects the response parameters for the VerifySecondFactor method.
+type VerifySecondFactorResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	Err          error  `json:"err"`
+}
+
+// MakeVerifySecondFactorEndpoint returns an endpoint that invokes VerifySecondFactor on the service.
+func MakeVerifySecondFactorEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(VerifySecondFactorRequest)
+		accessToken, refreshToken, err := s.VerifySecondFactor(ctx, req.Challenge, req.Code)
+		return VerifySecondFactorResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			Err:          err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r VerifySecondFactorResponse) Failed() error {
+	return r.Err
+}
+
+// ListSessionsRequest collects the request parameters for the ListSessions method.
+type ListSessionsRequest struct {
+	Id string `json:"id"`
+}
+
+// ListSessionsResponse collects the response parameters for the ListSessions method.
+type ListSessionsResponse struct {
+	Items []model.Session `json:"items"`
+	Err   error           `json:"err"`
+}
+
+// MakeListSessionsEndpoint returns an endpoint that invokes ListSessions on the service.
+func MakeListSessionsEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListSessionsRequest)
+		items, err := s.ListSessions(ctx, req.Id)
+		return ListSessionsResponse{
+			Items: items,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ListSessionsResponse) Failed() error {
+	return r.Err
+}
+
+// RevokeSessionRequest collects the request parameters for the RevokeSession method.
+type RevokeSessionRequest struct {
+	Id        string `json:"id"`
+	SessionID string `json:"session_id"`
+}
+
+// RevokeSessionResponse collects the response parameters for the RevokeSession method.
+type RevokeSessionResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeRevokeSessionEndpoint returns an endpoint that invokes RevokeSession on the service.
+func MakeRevokeSessionEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RevokeSessionRequest)
+		response, err := s.RevokeSession(ctx, req.Id, req.SessionID)
+		return RevokeSessionResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r RevokeSessionResponse) Failed() error {
+	return r.Err
+}
+
+// OIDCLoginRequest collects the request parameters for the OIDCLogin method.
+type OIDCLoginRequest struct{}
+
+// OIDCLoginResponse collects the response parameters for the OIDCLogin method.
+type OIDCLoginResponse struct {
+	Url string `json:"url"`
+	Err error  `json:"err"`
+}
+
+// MakeOIDCLoginEndpoint returns an endpoint that invokes OIDCLogin on the service.
+func MakeOIDCLoginEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(OIDCLoginRequest)
+		url, err := s.OIDCLogin(ctx)
+		return OIDCLoginResponse{
+			Url: url,
+			Err: err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r OIDCLoginResponse) Failed() error {
+	return r.Err
+}
+
+// OIDCCallbackRequest collects the request parameters for the OIDCCallback method.
+type OIDCCallbackRequest struct {
+	State string `json:"state"`
+	Code  string `json:"code"`
+}
+
+// OIDCCallbackResponse collects the response parameters for the OIDCCallback method.
+type OIDCCallbackResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	Challenge    string `json:"challenge"`
+	Err          error  `json:"err"`
+}
+
+// MakeOIDCCallbackEndpoint returns an endpoint that invokes OIDCCallback on the service.
+func MakeOIDCCallbackEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(OIDCCallbackRequest)
+		accessToken, refreshToken, challenge, err := s.OIDCCallback(ctx, req.State, req.Code)
+		return OIDCCallbackResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			Challenge:    challenge,
+			Err:          err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r OIDCCallbackResponse) Failed() error {
+	return r.Err
+}
+
+// CreateAPIKeyRequest collects the request parameters for the CreateAPIKey method.
+type CreateAPIKeyRequest struct {
+	Id        string   `json:"id"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	ExpiresIn int64    `json:"expires_in"`
+}
+
+// CreateAPIKeyResponse collects the response parameters for the CreateAPIKey method.
+type CreateAPIKeyResponse struct {
+	KeyID string `json:"key_id"`
+	Key   string `json:"key"`
+	Err   error  `json:"err"`
+}
+
+// MakeCreateAPIKeyEndpoint returns an endpoint that invokes CreateAPIKey on the service.
+func MakeCreateAPIKeyEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateAPIKeyRequest)
+		keyID, key, err := s.CreateAPIKey(ctx, req.Id, req.Name, req.Scopes, req.ExpiresIn)
+		return CreateAPIKeyResponse{
+			KeyID: keyID,
+			Key:   key,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r CreateAPIKeyResponse) Failed() error {
+	return r.Err
+}
+
+// ListAPIKeysRequest collects the request parameters for the ListAPIKeys method.
+type ListAPIKeysRequest struct {
+	Id string `json:"id"`
+}
+
+// ListAPIKeysResponse collects the response parameters for the ListAPIKeys method.
+type ListAPIKeysResponse struct {
+	Items []model.APIKey `json:"items"`
+	Err   error          `json:"err"`
+}
+
+// MakeListAPIKeysEndpoint returns an endpoint that invokes ListAPIKeys on the service.
+func MakeListAPIKeysEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListAPIKeysRequest)
+		items, err := s.ListAPIKeys(ctx, req.Id)
+		return ListAPIKeysResponse{
+			Items: items,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ListAPIKeysResponse) Failed() error {
+	return r.Err
+}
+
+// RevokeAPIKeyRequest collects the request parameters for the RevokeAPIKey method.
+type RevokeAPIKeyRequest struct {
+	Id    string `json:"id"`
+	KeyID string `json:"key_id"`
+}
+
+// RevokeAPIKeyResponse collects the response parameters for the RevokeAPIKey method.
+type RevokeAPIKeyResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeRevokeAPIKeyEndpoint returns an endpoint that invokes RevokeAPIKey on the service.
+func MakeRevokeAPIKeyEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RevokeAPIKeyRequest)
+		response, err := s.RevokeAPIKey(ctx, req.Id, req.KeyID)
+		return RevokeAPIKeyResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r RevokeAPIKeyResponse) Failed() error {
+	return r.Err
+}
+
+// DeleteAccountRequest collects the request parameters for the DeleteAccount method.
+type DeleteAccountRequest struct {
+	Id       string `json:"id"`
+	Password string `json:"password"`
+}
+
+// DeleteAccountResponse collects the response parameters for the DeleteAccount method.
+type DeleteAccountResponse struct {
+	DeleteAt string `json:"delete_at"`
+	Err      error  `json:"err"`
+}
+
+// MakeDeleteAccountEndpoint returns an endpoint that invokes DeleteAccount on the service.
+func MakeDeleteAccountEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteAccountRequest)
+		deleteAt, err := s.DeleteAccount(ctx, req.Id, req.Password)
+		return DeleteAccountResponse{
+			DeleteAt: deleteAt,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r DeleteAccountResponse) Failed() error {
+	return r.Err
+}
+
+// ExportMyDataRequest collects the request parameters for the ExportMyData method.
+type ExportMyDataRequest struct {
+	Id string `json:"id"`
+}
+
+// ExportMyDataResponse collects the response parameters for the ExportMyData method.
+type ExportMyDataResponse struct {
+	JobID string `json:"job_id"`
+	Err   error  `json:"err"`
+}
+
+// MakeExportMyDataEndpoint returns an endpoint that invokes ExportMyData on the service.
+func MakeExportMyDataEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ExportMyDataRequest)
+		jobID, err := s.ExportMyData(ctx, req.Id)
+		return ExportMyDataResponse{
+			JobID: jobID,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ExportMyDataResponse) Failed() error {
+	return r.Err
+}
+
+// ExportStatusRequest collects the request parameters for the ExportStatus method.
+type ExportStatusRequest struct {
+	Id    string `json:"id"`
+	JobID string `json:"job_id"`
+}
+
+// ExportStatusResponse collects the response parameters for the ExportStatus method.
+type ExportStatusResponse struct {
+	State       string `json:"state"`
+	DownloadURL string `json:"download_url"`
+	Err         error  `json:"err"`
+}
+
+// MakeExportStatusEndpoint returns an endpoint that invokes ExportStatus on the service.
+func MakeExportStatusEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ExportStatusRequest)
+		state, downloadURL, err := s.ExportStatus(ctx, req.Id, req.JobID)
+		return ExportStatusResponse{
+			State:       state,
+			DownloadURL: downloadURL,
+			Err:         err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ExportStatusResponse) Failed() error {
+	return r.Err
+}
+
+// GetPublicProfileRequest collects the request parameters for the GetPublicProfile method.
+type GetPublicProfileRequest struct {
+	Id string `json:"id"`
+}
+
+// GetPublicProfileResponse collects the response parameters for the GetPublicProfile method.
+type GetPublicProfileResponse struct {
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+	Bio         string `json:"bio"`
+	AvatarURL   string `json:"avatar_url"`
+	JoinedAt    string `json:"joined_at"`
+	Err         error  `json:"err"`
+}
+
+// MakeGetPublicProfileEndpoint returns an endpoint that invokes GetPublicProfile on the service.
+func MakeGetPublicProfileEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetPublicProfileRequest)
+		username, displayName, bio, avatarURL, joinedAt, err := s.GetPublicProfile(ctx, req.Id)
+		return GetPublicProfileResponse{
+			Username:    username,
+			DisplayName: displayName,
+			Bio:         bio,
+			AvatarURL:   avatarURL,
+			JoinedAt:    joinedAt,
+			Err:         err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r GetPublicProfileResponse) Failed() error {
+	return r.Err
+}
+
+// UpdatePublicProfileRequest collects the request parameters for the UpdatePublicProfile method.
+type UpdatePublicProfileRequest struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"display_name"`
+	Bio         string `json:"bio"`
+	AvatarURL   string `json:"avatar_url"`
+}
+
+// UpdatePublicProfileResponse collects the response parameters for the UpdatePublicProfile method.
+type UpdatePublicProfileResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeUpdatePublicProfileEndpoint returns an endpoint that invokes UpdatePublicProfile on the service.
+func MakeUpdatePublicProfileEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdatePublicProfileRequest)
+		response, err := s.UpdatePublicProfile(ctx, req.Id, req.DisplayName, req.Bio, req.AvatarURL)
+		return UpdatePublicProfileResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r UpdatePublicProfileResponse) Failed() error {
+	return r.Err
+}
+
+// FollowRequest collects the request parameters for the Follow method.
+type FollowRequest struct {
+	Id       string `json:"id"`
+	AuthorID string `json:"author_id"`
+}
+
+// FollowResponse collects the response parameters for the Follow method.
+type FollowResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeFollowEndpoint returns an endpoint that invokes Follow on the service.
+func MakeFollowEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FollowRequest)
+		response, err := s.Follow(ctx, req.Id, req.AuthorID)
+		return FollowResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r FollowResponse) Failed() error {
+	return r.Err
+}
+
+// UnfollowRequest collects the request parameters for the Unfollow method.
+type UnfollowRequest struct {
+	Id       string `json:"id"`
+	AuthorID string `json:"author_id"`
+}
+
+// UnfollowResponse collects the response parameters for the Unfollow method.
+type UnfollowResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeUnfollowEndpoint returns an endpoint that invokes Unfollow on the service.
+func MakeUnfollowEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UnfollowRequest)
+		response, err := s.Unfollow(ctx, req.Id, req.AuthorID)
+		return UnfollowResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r UnfollowResponse) Failed() error {
+	return r.Err
+}
+
+// ListFollowersRequest collects the request parameters for the ListFollowers method.
+type ListFollowersRequest struct {
+	Id     string `json:"id"`
+	Limit  int64  `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+// ListFollowersResponse collects the response parameters for the ListFollowers method.
+type ListFollowersResponse struct {
+	Items []model.Follow `json:"items"`
+	Total int64          `json:"total"`
+	Next  string         `json:"next"`
+	Err   error          `json:"err"`
+}
+
+// MakeListFollowersEndpoint returns an endpoint that invokes ListFollowers on the service.
+func MakeListFollowersEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListFollowersRequest)
+		items, total, next, err := s.ListFollowers(ctx, req.Id, req.Limit, req.Cursor)
+		return ListFollowersResponse{
+			Items: items,
+			Total: total,
+			Next:  next,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ListFollowersResponse) Failed() error {
+	return r.Err
+}
+
+// ListFollowingRequest collects the request parameters for the ListFollowing method.
+type ListFollowingRequest struct {
+	Id     string `json:"id"`
+	Limit  int64  `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+// ListFollowingResponse collects the response parameters for the ListFollowing method.
+type ListFollowingResponse struct {
+	Items []model.Follow `json:"items"`
+	Total int64          `json:"total"`
+	Next  string         `json:"next"`
+	Err   error          `json:"err"`
+}
+
+// MakeListFollowingEndpoint returns an endpoint that invokes ListFollowing on the service.
+func MakeListFollowingEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListFollowingRequest)
+		items, total, next, err := s.ListFollowing(ctx, req.Id, req.Limit, req.Cursor)
+		return ListFollowingResponse{
+			Items: items,
+			Total: total,
+			Next:  next,
+			Err:   err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r ListFollowingResponse) Failed() error {
+	return r.Err
+}
+
+// CancelDeletionRequest collects the request parameters for the CancelDeletion method.
+type CancelDeletionRequest struct {
+	Id string `json:"id"`
+}
+
+// CancelDeletionResponse collects the response parameters for the CancelDeletion method.
+type CancelDeletionResponse struct {
+	Response string `json:"response"`
+	Err      error  `json:"err"`
+}
+
+// MakeCancelDeletionEndpoint returns an endpoint that invokes CancelDeletion on the service.
+func MakeCancelDeletionEndpoint(s service.UsersService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CancelDeletionRequest)
+		response, err := s.CancelDeletion(ctx, req.Id)
+		return CancelDeletionResponse{
+			Response: response,
+			Err:      err,
+		}, nil
+	}
+}
+
+// Failed implements Failer.
+func (r CancelDeletionResponse) Failed() error {
+	return r.Err
+}
+
 // Failure is an interface that should be implemented by response types.
 // Response encoders can check if responses are Failer, and if so they've
 // failed, and if so encode them using a separate write path based on the error.
@@ -76,7 +959,7 @@ type Failure interface {
 }
 
 // Login implements Service. Primarily useful in a client.
-func (e Endpoints) Login(ctx context.Context, username string, password string) (s0 string, e1 error) {
+func (e Endpoints) Login(ctx context.Context, username string, password string) (s0 string, challenge string, e1 error) {
 	request := LoginRequest{
 		Password: password,
 		Username: username,
@@ -85,7 +968,7 @@ func (e Endpoints) Login(ctx context.Context, username string, password string)
 	if err != nil {
 		return
 	}
-	return response.(LoginResponse).S0, response.(LoginResponse).E1
+	return response.(LoginResponse).S0, response.(LoginResponse).Challenge, response.(LoginResponse).E1
 }
 
 // Register implements Service. Primarily useful in a client.
@@ -103,25 +986,25 @@ func (e Endpoints) Register(ctx context.Context, username string, password strin
 	return response.(RegisterResponse).S0, response.(RegisterResponse).E1
 }
 
-// GetRequest collects the request parameters for the Get method.
-type GetRequest struct {
+// GetPrivateRequest collects the request parameters for the GetPrivate method.
+type GetPrivateRequest struct {
 	Id string `json:"id"`
 }
 
-// GetResponse collects the response parameters for the Get method.
-type GetResponse struct {
+// GetPrivateResponse collects the response parameters for the GetPrivate method.
+type GetPrivateResponse struct {
 	S0 string `json:"username"`
 	S1 string `json:"email"`
 	S2 string `json:"phone"`
 	E1 error  `json:"error"`
 }
 
-// MakeGetEndpoint returns an endpoint that invokes Get on the service.
-func MakeGetEndpoint(s service.UsersService) endpoint.Endpoint {
+// MakeGetPrivateEndpoint returns an endpoint that invokes GetPrivate on the service.
+func MakeGetPrivateEndpoint(s service.UsersService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(GetRequest)
-		s0, s1, s2, e1 := s.Get(ctx, req.Id)
-		return GetResponse{
+		req := request.(GetPrivateRequest)
+		s0, s1, s2, e1 := s.GetPrivate(ctx, req.Id)
+		return GetPrivateResponse{
 			E1: e1,
 			S0: s0,
 			S1: s1,
@@ -131,16 +1014,306 @@ func MakeGetEndpoint(s service.UsersService) endpoint.Endpoint {
 }
 
 // Failed implements Failer.
-func (r GetResponse) Failed() error {
+func (r GetPrivateResponse) Failed() error {
 	return r.E1
 }
 
-// Get implements Service. Primarily useful in a client.
-func (e Endpoints) Get(ctx context.Context, id string) (s0 string, s1 string, s2 string, e1 error) {
-	request := GetRequest{Id: id}
-	response, err := e.GetEndpoint(ctx, request)
+// GetPrivate implements Service. Primarily useful in a client.
+func (e Endpoints) GetPrivate(ctx context.Context, id string) (s0 string, s1 string, s2 string, e1 error) {
+	request := GetPrivateRequest{Id: id}
+	response, err := e.GetPrivateEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(GetPrivateResponse).S0, response.(GetPrivateResponse).S1, response.(GetPrivateResponse).S2, response.(GetPrivateResponse).E1
+}
+
+// RequestVerification implements Service. Primarily useful in a client.
+func (e Endpoints) RequestVerification(ctx context.Context, id string, channel string) (expiresIn int64, err error) {
+	request := RequestVerificationRequest{Id: id, Channel: channel}
+	response, err := e.RequestVerificationEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(RequestVerificationResponse).ExpiresIn, response.(RequestVerificationResponse).Err
+}
+
+// ConfirmVerification implements Service. Primarily useful in a client.
+func (e Endpoints) ConfirmVerification(ctx context.Context, id string, channel string, code string) (verified bool, err error) {
+	request := ConfirmVerificationRequest{Id: id, Channel: channel, Code: code}
+	response, err := e.ConfirmVerificationEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ConfirmVerificationResponse).Verified, response.(ConfirmVerificationResponse).Err
+}
+
+// Authenticate implements Service. Primarily useful in a client.
+func (e Endpoints) Authenticate(ctx context.Context, token string) (id string, username string, roles []string, scopes []string, keyID string, err error) {
+	request := AuthenticateRequest{Token: token}
+	response, err := e.AuthenticateEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(AuthenticateResponse).Id, response.(AuthenticateResponse).Username, response.(AuthenticateResponse).Roles, response.(AuthenticateResponse).Scopes, response.(AuthenticateResponse).KeyID, response.(AuthenticateResponse).Err
+}
+
+// RequestPasswordReset implements Service. Primarily useful in a client.
+func (e Endpoints) RequestPasswordReset(ctx context.Context, email string) (response string, err error) {
+	request := RequestPasswordResetRequest{Email: email}
+	response0, err := e.RequestPasswordResetEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response0.(RequestPasswordResetResponse).Response, response0.(RequestPasswordResetResponse).Err
+}
+
+// ResetPassword implements Service. Primarily useful in a client.
+func (e Endpoints) ResetPassword(ctx context.Context, token string, password string) (response string, err error) {
+	request := ResetPasswordRequest{Token: token, Password: password}
+	response0, err := e.ResetPasswordEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response0.(ResetPasswordResponse).Response, response0.(ResetPasswordResponse).Err
+}
+
+// UpdateProfile implements Service. Primarily useful in a client.
+func (e Endpoints) UpdateProfile(ctx context.Context, id string, username string, email string, phone string) (newUsername string, newEmail string, newPhone string, err error) {
+	request := UpdateProfileRequest{Id: id, Username: username, Email: email, Phone: phone}
+	response, err := e.UpdateProfileEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(UpdateProfileResponse).NewUsername, response.(UpdateProfileResponse).NewEmail, response.(UpdateProfileResponse).NewPhone, response.(UpdateProfileResponse).Err
+}
+
+// ChangePassword implements Service. Primarily useful in a client.
+func (e Endpoints) ChangePassword(ctx context.Context, id string, current string, password string) (response string, err error) {
+	request := ChangePasswordRequest{Id: id, Current: current, Password: password}
+	response0, err := e.ChangePasswordEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response0.(ChangePasswordResponse).Response, response0.(ChangePasswordResponse).Err
+}
+
+// GrantRole implements Service. Primarily useful in a client.
+func (e Endpoints) GrantRole(ctx context.Context, id string, role string) (roles []string, err error) {
+	request := GrantRoleRequest{Id: id, Role: role}
+	response, err := e.GrantRoleEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(GrantRoleResponse).Roles, response.(GrantRoleResponse).Err
+}
+
+// RevokeRole implements Service. Primarily useful in a client.
+func (e Endpoints) RevokeRole(ctx context.Context, id string, role string) (roles []string, err error) {
+	request := RevokeRoleRequest{Id: id, Role: role}
+	response, err := e.RevokeRoleEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(RevokeRoleResponse).Roles, response.(RevokeRoleResponse).Err
+}
+
+// EnrollTOTP implements Service. Primarily useful in a client.
+func (e Endpoints) EnrollTOTP(ctx context.Context, id string) (secret string, uri string, err error) {
+	request := EnrollTOTPRequest{Id: id}
+	response, err := e.EnrollTOTPEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(EnrollTOTPResponse).Secret, response.(EnrollTOTPResponse).Uri, response.(EnrollTOTPResponse).Err
+}
+
+// ConfirmTOTP implements Service. Primarily useful in a client.
+func (e Endpoints) ConfirmTOTP(ctx context.Context, id string, code string) (recoveryCodes []string, err error) {
+	request := ConfirmTOTPRequest{Id: id, Code: code}
+	response, err := e.ConfirmTOTPEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ConfirmTOTPResponse).RecoveryCodes, response.(ConfirmTOTPResponse).Err
+}
+
+// VerifySecondFactor implements Service. Primarily useful in a client.
+func (e Endpoints) VerifySecondFactor(ctx context.Context, challenge string, code string) (accessToken string, refreshToken string, err error) {
+	request := VerifySecondFactorRequest{Challenge: challenge, Code: code}
+	response, err := e.VerifySecondFactorEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(VerifySecondFactorResponse).AccessToken, response.(VerifySecondFactorResponse).RefreshToken, response.(VerifySecondFactorResponse).Err
+}
+
+// ListSessions implements Service. Primarily useful in a client.
+func (e Endpoints) ListSessions(ctx context.Context, id string) (items []model.Session, err error) {
+	request := ListSessionsRequest{Id: id}
+	response, err := e.ListSessionsEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ListSessionsResponse).Items, response.(ListSessionsResponse).Err
+}
+
+// RevokeSession implements Service. Primarily useful in a client.
+func (e Endpoints) RevokeSession(ctx context.Context, id string, sessionID string) (response string, err error) {
+	request := RevokeSessionRequest{Id: id, SessionID: sessionID}
+	response0, err := e.RevokeSessionEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response0.(RevokeSessionResponse).Response, response0.(RevokeSessionResponse).Err
+}
+
+// OIDCLogin implements Service. Primarily useful in a client.
+func (e Endpoints) OIDCLogin(ctx context.Context) (url string, err error) {
+	request := OIDCLoginRequest{}
+	response, err := e.OIDCLoginEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(OIDCLoginResponse).Url, response.(OIDCLoginResponse).Err
+}
+
+// OIDCCallback implements Service. Primarily useful in a client.
+func (e Endpoints) OIDCCallback(ctx context.Context, state string, code string) (accessToken string, refreshToken string, challenge string, err error) {
+	request := OIDCCallbackRequest{State: state, Code: code}
+	response, err := e.OIDCCallbackEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(OIDCCallbackResponse).AccessToken, response.(OIDCCallbackResponse).RefreshToken, response.(OIDCCallbackResponse).Challenge, response.(OIDCCallbackResponse).Err
+}
+
+// CreateAPIKey implements Service. Primarily useful in a client.
+func (e Endpoints) CreateAPIKey(ctx context.Context, id string, name string, scopes []string, expiresIn int64) (keyID string, key string, err error) {
+	request := CreateAPIKeyRequest{Id: id, Name: name, Scopes: scopes, ExpiresIn: expiresIn}
+	response, err := e.CreateAPIKeyEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(CreateAPIKeyResponse).KeyID, response.(CreateAPIKeyResponse).Key, response.(CreateAPIKeyResponse).Err
+}
+
+// ListAPIKeys implements Service. Primarily useful in a client.
+func (e Endpoints) ListAPIKeys(ctx context.Context, id string) (items []model.APIKey, err error) {
+	request := ListAPIKeysRequest{Id: id}
+	response, err := e.ListAPIKeysEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ListAPIKeysResponse).Items, response.(ListAPIKeysResponse).Err
+}
+
+// RevokeAPIKey implements Service. Primarily useful in a client.
+func (e Endpoints) RevokeAPIKey(ctx context.Context, id string, keyID string) (response string, err error) {
+	request := RevokeAPIKeyRequest{Id: id, KeyID: keyID}
+	response0, err := e.RevokeAPIKeyEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response0.(RevokeAPIKeyResponse).Response, response0.(RevokeAPIKeyResponse).Err
+}
+
+// DeleteAccount implements Service. Primarily useful in a client.
+func (e Endpoints) DeleteAccount(ctx context.Context, id string, password string) (deleteAt string, err error) {
+	request := DeleteAccountRequest{Id: id, Password: password}
+	response, err := e.DeleteAccountEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(DeleteAccountResponse).DeleteAt, response.(DeleteAccountResponse).Err
+}
+
+// ExportMyData implements Service. Primarily useful in a client.
+func (e Endpoints) ExportMyData(ctx context.Context, id string) (jobID string, err error) {
+	request := ExportMyDataRequest{Id: id}
+	response, err := e.ExportMyDataEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ExportMyDataResponse).JobID, response.(ExportMyDataResponse).Err
+}
+
+// ExportStatus implements Service. Primarily useful in a client.
+func (e Endpoints) ExportStatus(ctx context.Context, id string, jobID string) (state string, downloadURL string, err error) {
+	request := ExportStatusRequest{Id: id, JobID: jobID}
+	response, err := e.ExportStatusEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ExportStatusResponse).State, response.(ExportStatusResponse).DownloadURL, response.(ExportStatusResponse).Err
+}
+
+// GetPublicProfile implements Service. Primarily useful in a client.
+func (e Endpoints) GetPublicProfile(ctx context.Context, id string) (username string, displayName string, bio string, avatarURL string, joinedAt string, err error) {
+	request := GetPublicProfileRequest{Id: id}
+	response, err := e.GetPublicProfileEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(GetPublicProfileResponse).Username, response.(GetPublicProfileResponse).DisplayName, response.(GetPublicProfileResponse).Bio, response.(GetPublicProfileResponse).AvatarURL, response.(GetPublicProfileResponse).JoinedAt, response.(GetPublicProfileResponse).Err
+}
+
+// UpdatePublicProfile implements Service. Primarily useful in a client.
+func (e Endpoints) UpdatePublicProfile(ctx context.Context, id string, displayName string, bio string, avatarURL string) (response string, err error) {
+	request := UpdatePublicProfileRequest{Id: id, DisplayName: displayName, Bio: bio, AvatarURL: avatarURL}
+	response0, err := e.UpdatePublicProfileEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response0.(UpdatePublicProfileResponse).Response, response0.(UpdatePublicProfileResponse).Err
+}
+
+// Follow implements Service. Primarily useful in a client.
+func (e Endpoints) Follow(ctx context.Context, id string, authorID string) (response string, err error) {
+	request := FollowRequest{Id: id, AuthorID: authorID}
+	response0, err := e.FollowEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response0.(FollowResponse).Response, response0.(FollowResponse).Err
+}
+
+// Unfollow implements Service. Primarily useful in a client.
+func (e Endpoints) Unfollow(ctx context.Context, id string, authorID string) (response string, err error) {
+	request := UnfollowRequest{Id: id, AuthorID: authorID}
+	response0, err := e.UnfollowEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response0.(UnfollowResponse).Response, response0.(UnfollowResponse).Err
+}
+
+// ListFollowers implements Service. Primarily useful in a client.
+func (e Endpoints) ListFollowers(ctx context.Context, id string, limit int64, cursor string) (items []model.Follow, total int64, next string, err error) {
+	request := ListFollowersRequest{Id: id, Limit: limit, Cursor: cursor}
+	response, err := e.ListFollowersEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ListFollowersResponse).Items, response.(ListFollowersResponse).Total, response.(ListFollowersResponse).Next, response.(ListFollowersResponse).Err
+}
+
+// ListFollowing implements Service. Primarily useful in a client.
+func (e Endpoints) ListFollowing(ctx context.Context, id string, limit int64, cursor string) (items []model.Follow, total int64, next string, err error) {
+	request := ListFollowingRequest{Id: id, Limit: limit, Cursor: cursor}
+	response, err := e.ListFollowingEndpoint(ctx, request)
+	if err != nil {
+		return
+	}
+	return response.(ListFollowingResponse).Items, response.(ListFollowingResponse).Total, response.(ListFollowingResponse).Next, response.(ListFollowingResponse).Err
+}
+
+// CancelDeletion implements Service. Primarily useful in a client.
+func (e Endpoints) CancelDeletion(ctx context.Context, id string) (response string, err error) {
+	request := CancelDeletionRequest{Id: id}
+	response0, err := e.CancelDeletionEndpoint(ctx, request)
 	if err != nil {
 		return
 	}
-	return response.(GetResponse).S0, response.(GetResponse).S1, response.(GetResponse).S2, response.(GetResponse).E1
+	return response0.(CancelDeletionResponse).Response, response0.(CancelDeletionResponse).Err
 }
@@ -11,21 +11,79 @@ import (
 // meant to be used as a helper struct, to collect all of the endpoints into a
 // single parameter.
 type Endpoints struct {
-	GetEndpoint      endpoint.Endpoint
-	LoginEndpoint    endpoint.Endpoint
-	RegisterEndpoint endpoint.Endpoint
+	GetPrivateEndpoint           endpoint.Endpoint
+	LoginEndpoint                endpoint.Endpoint
+	RegisterEndpoint             endpoint.Endpoint
+	RequestVerificationEndpoint  endpoint.Endpoint
+	ConfirmVerificationEndpoint  endpoint.Endpoint
+	AuthenticateEndpoint         endpoint.Endpoint
+	RequestPasswordResetEndpoint endpoint.Endpoint
+	ResetPasswordEndpoint        endpoint.Endpoint
+	UpdateProfileEndpoint        endpoint.Endpoint
+	ChangePasswordEndpoint       endpoint.Endpoint
+	GrantRoleEndpoint            endpoint.Endpoint
+	RevokeRoleEndpoint           endpoint.Endpoint
+	EnrollTOTPEndpoint           endpoint.Endpoint
+	ConfirmTOTPEndpoint          endpoint.Endpoint
+	VerifySecondFactorEndpoint   endpoint.Endpoint
+	ListSessionsEndpoint         endpoint.Endpoint
+	RevokeSessionEndpoint        endpoint.Endpoint
+	OIDCLoginEndpoint            endpoint.Endpoint
+	OIDCCallbackEndpoint         endpoint.Endpoint
+	CreateAPIKeyEndpoint         endpoint.Endpoint
+	ListAPIKeysEndpoint          endpoint.Endpoint
+	RevokeAPIKeyEndpoint         endpoint.Endpoint
+	DeleteAccountEndpoint        endpoint.Endpoint
+	ExportMyDataEndpoint         endpoint.Endpoint
+	ExportStatusEndpoint         endpoint.Endpoint
+	GetPublicProfileEndpoint     endpoint.Endpoint
+	UpdatePublicProfileEndpoint  endpoint.Endpoint
+	FollowEndpoint               endpoint.Endpoint
+	UnfollowEndpoint             endpoint.Endpoint
+	ListFollowersEndpoint        endpoint.Endpoint
+	ListFollowingEndpoint        endpoint.Endpoint
+	CancelDeletionEndpoint       endpoint.Endpoint
 }
 
 // New returns a Endpoints struct that wraps the provided service, and wires in all of the
 // expected endpoint middlewares
 func New(s service.UsersService, mdw map[string][]endpoint.Middleware) Endpoints {
 	eps := Endpoints{
-		GetEndpoint:      MakeGetEndpoint(s),
-		LoginEndpoint:    MakeLoginEndpoint(s),
-		RegisterEndpoint: MakeRegisterEndpoint(s),
+		AuthenticateEndpoint:         MakeAuthenticateEndpoint(s),
+		CancelDeletionEndpoint:       MakeCancelDeletionEndpoint(s),
+		ChangePasswordEndpoint:       MakeChangePasswordEndpoint(s),
+		ConfirmTOTPEndpoint:          MakeConfirmTOTPEndpoint(s),
+		ConfirmVerificationEndpoint:  MakeConfirmVerificationEndpoint(s),
+		CreateAPIKeyEndpoint:         MakeCreateAPIKeyEndpoint(s),
+		DeleteAccountEndpoint:        MakeDeleteAccountEndpoint(s),
+		EnrollTOTPEndpoint:           MakeEnrollTOTPEndpoint(s),
+		ExportMyDataEndpoint:         MakeExportMyDataEndpoint(s),
+		ExportStatusEndpoint:         MakeExportStatusEndpoint(s),
+		FollowEndpoint:               MakeFollowEndpoint(s),
+		GetPrivateEndpoint:           MakeGetPrivateEndpoint(s),
+		GetPublicProfileEndpoint:     MakeGetPublicProfileEndpoint(s),
+		GrantRoleEndpoint:            MakeGrantRoleEndpoint(s),
+		ListAPIKeysEndpoint:          MakeListAPIKeysEndpoint(s),
+		ListFollowersEndpoint:        MakeListFollowersEndpoint(s),
+		ListFollowingEndpoint:        MakeListFollowingEndpoint(s),
+		ListSessionsEndpoint:         MakeListSessionsEndpoint(s),
+		LoginEndpoint:                MakeLoginEndpoint(s),
+		OIDCCallbackEndpoint:         MakeOIDCCallbackEndpoint(s),
+		OIDCLoginEndpoint:            MakeOIDCLoginEndpoint(s),
+		RegisterEndpoint:             MakeRegisterEndpoint(s),
+		RequestPasswordResetEndpoint: MakeRequestPasswordResetEndpoint(s),
+		RequestVerificationEndpoint:  MakeRequestVerificationEndpoint(s),
+		ResetPasswordEndpoint:        MakeResetPasswordEndpoint(s),
+		RevokeAPIKeyEndpoint:         MakeRevokeAPIKeyEndpoint(s),
+		RevokeRoleEndpoint:           MakeRevokeRoleEndpoint(s),
+		RevokeSessionEndpoint:        MakeRevokeSessionEndpoint(s),
+		UnfollowEndpoint:             MakeUnfollowEndpoint(s),
+		UpdateProfileEndpoint:        MakeUpdateProfileEndpoint(s),
+		UpdatePublicProfileEndpoint:  MakeUpdatePublicProfileEndpoint(s),
+		VerifySecondFactorEndpoint:   MakeVerifySecondFactorEndpoint(s),
 	}
-	for _, m := range mdw["Get"] {
-		eps.GetEndpoint = m(eps.GetEndpoint)
+	for _, m := range mdw["GetPrivate"] {
+		eps.GetPrivateEndpoint = m(eps.GetPrivateEndpoint)
 	}
 	for _, m := range mdw["Login"] {
 		eps.LoginEndpoint = m(eps.LoginEndpoint)
@@ -33,5 +91,92 @@ func New(s service.UsersService, mdw map[string][]endpoint.Middleware) Endpoints
 	for _, m := range mdw["Register"] {
 		eps.RegisterEndpoint = m(eps.RegisterEndpoint)
 	}
+	for _, m := range mdw["RequestVerification"] {
+		eps.RequestVerificationEndpoint = m(eps.RequestVerificationEndpoint)
+	}
+	for _, m := range mdw["ConfirmVerification"] {
+		eps.ConfirmVerificationEndpoint = m(eps.ConfirmVerificationEndpoint)
+	}
+	for _, m := range mdw["Authenticate"] {
+		eps.AuthenticateEndpoint = m(eps.AuthenticateEndpoint)
+	}
+	for _, m := range mdw["RequestPasswordReset"] {
+		eps.RequestPasswordResetEndpoint = m(eps.RequestPasswordResetEndpoint)
+	}
+	for _, m := range mdw["ResetPassword"] {
+		eps.ResetPasswordEndpoint = m(eps.ResetPasswordEndpoint)
+	}
+	for _, m := range mdw["UpdateProfile"] {
+		eps.UpdateProfileEndpoint = m(eps.UpdateProfileEndpoint)
+	}
+	for _, m := range mdw["ChangePassword"] {
+		eps.ChangePasswordEndpoint = m(eps.ChangePasswordEndpoint)
+	}
+	for _, m := range mdw["GrantRole"] {
+		eps.GrantRoleEndpoint = m(eps.GrantRoleEndpoint)
+	}
+	for _, m := range mdw["RevokeRole"] {
+		eps.RevokeRoleEndpoint = m(eps.RevokeRoleEndpoint)
+	}
+	for _, m := range mdw["EnrollTOTP"] {
+		eps.EnrollTOTPEndpoint = m(eps.EnrollTOTPEndpoint)
+	}
+	for _, m := range mdw["ConfirmTOTP"] {
+		eps.ConfirmTOTPEndpoint = m(eps.ConfirmTOTPEndpoint)
+	}
+	for _, m := range mdw["VerifySecondFactor"] {
+		eps.VerifySecondFactorEndpoint = m(eps.VerifySecondFactorEndpoint)
+	}
+	for _, m := range mdw["ListSessions"] {
+		eps.ListSessionsEndpoint = m(eps.ListSessionsEndpoint)
+	}
+	for _, m := range mdw["RevokeSession"] {
+		eps.RevokeSessionEndpoint = m(eps.RevokeSessionEndpoint)
+	}
+	for _, m := range mdw["OIDCLogin"] {
+		eps.OIDCLoginEndpoint = m(eps.OIDCLoginEndpoint)
+	}
+	for _, m := range mdw["OIDCCallback"] {
+		eps.OIDCCallbackEndpoint = m(eps.OIDCCallbackEndpoint)
+	}
+	for _, m := range mdw["CreateAPIKey"] {
+		eps.CreateAPIKeyEndpoint = m(eps.CreateAPIKeyEndpoint)
+	}
+	for _, m := range mdw["ListAPIKeys"] {
+		eps.ListAPIKeysEndpoint = m(eps.ListAPIKeysEndpoint)
+	}
+	for _, m := range mdw["RevokeAPIKey"] {
+		eps.RevokeAPIKeyEndpoint = m(eps.RevokeAPIKeyEndpoint)
+	}
+	for _, m := range mdw["DeleteAccount"] {
+		eps.DeleteAccountEndpoint = m(eps.DeleteAccountEndpoint)
+	}
+	for _, m := range mdw["ExportMyData"] {
+		eps.ExportMyDataEndpoint = m(eps.ExportMyDataEndpoint)
+	}
+	for _, m := range mdw["ExportStatus"] {
+		eps.ExportStatusEndpoint = m(eps.ExportStatusEndpoint)
+	}
+	for _, m := range mdw["GetPublicProfile"] {
+		eps.GetPublicProfileEndpoint = m(eps.GetPublicProfileEndpoint)
+	}
+	for _, m := range mdw["UpdatePublicProfile"] {
+		eps.UpdatePublicProfileEndpoint = m(eps.UpdatePublicProfileEndpoint)
+	}
+	for _, m := range mdw["Follow"] {
+		eps.FollowEndpoint = m(eps.FollowEndpoint)
+	}
+	for _, m := range mdw["Unfollow"] {
+		eps.UnfollowEndpoint = m(eps.UnfollowEndpoint)
+	}
+	for _, m := range mdw["ListFollowers"] {
+		eps.ListFollowersEndpoint = m(eps.ListFollowersEndpoint)
+	}
+	for _, m := range mdw["ListFollowing"] {
+		eps.ListFollowingEndpoint = m(eps.ListFollowingEndpoint)
+	}
+	for _, m := range mdw["CancelDeletion"] {
+		eps.CancelDeletionEndpoint = m(eps.CancelDeletionEndpoint)
+	}
 	return eps
 }
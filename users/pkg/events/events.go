@@ -0,0 +1,118 @@
+// Package events tells the other blog services what happened to users. The
+// events are kept in an outbox collection in mongo, which every service
+// already reaches, and each consumer marks the ones it is done with.
+package events
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Event types.
+const (
+	// UserDeleted is published when a user is removed after the grace
+	// period of DeleteAccount.
+	UserDeleted = "user.deleted"
+)
+
+// What the consumers of UserDeleted do with the content of the user.
+const (
+	PolicyAnonymize = "anonymize"
+	PolicyDelete    = "delete"
+)
+
+// Policies lists the valid content policies.
+var Policies = map[string]bool{
+	PolicyAnonymize: true,
+	PolicyDelete:    true,
+}
+
+// batch is how many events a consumer reads per poll.
+const batch = 100
+
+// Event is something that happened to a user.
+type Event struct {
+	ID     string    `bson:"_id"`
+	Type   string    `bson:"type"`
+	UserID string    `bson:"userID"`
+	At     time.Time `bson:"at"`
+	// Handled lists the consumers that are done with the event
+	Handled []string `bson:"handled"`
+}
+
+// Handler does what a consumer does on an event. It may see an event more
+// than once, so it must be idempotent.
+type Handler func(ctx context.Context, e Event) error
+
+// Outbox stores events until their consumers handled them.
+type Outbox struct {
+	col *mongo.Collection
+}
+
+// NewOutbox returns the outbox on the mongo of client.
+func NewOutbox(client *mongo.Client) *Outbox {
+	return &Outbox{col: client.Database("kit-events").Collection("events")}
+}
+
+// Publish stores e. Events are named by their ID, publishing one again is
+// a no-op.
+func (o *Outbox) Publish(ctx context.Context, e Event) error {
+	if e.At.IsZero() {
+		e.At = time.Now().UTC()
+	}
+	if e.Handled == nil {
+		e.Handled = []string{}
+	}
+	_, err := o.col.InsertOne(ctx, e)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	return err
+}
+
+// Consume hands the events of types that consumer has not handled yet to
+// handle, checking for new ones every interval until ctx is done. An event
+// that fails is tried again on the next poll.
+func (o *Outbox) Consume(ctx context.Context, consumer string, every time.Duration, handle Handler, types ...string) {
+	t := time.NewTicker(every)
+	defer t.Stop()
+	for {
+		if err := o.poll(ctx, consumer, handle, types); err != nil {
+			log.Printf("Error in consume events for %s: %v", consumer, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (o *Outbox) poll(ctx context.Context, consumer string, handle Handler, types []string) error {
+	filter := bson.M{"type": bson.M{"$in": types}, "handled": bson.M{"$ne": consumer}}
+	cur, err := o.col.Find(ctx, filter, options.Find().SetSort(bson.M{"at": 1}).SetLimit(batch))
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		e := Event{}
+		if err := cur.Decode(&e); err != nil {
+			return err
+		}
+		if err := handle(ctx, e); err != nil {
+			log.Printf("Error in handle %s event %s for %s: %v", e.Type, e.ID, consumer, err)
+			continue
+		}
+		if _, err := o.col.UpdateOne(ctx, bson.M{"_id": e.ID}, bson.M{"$addToSet": bson.M{"handled": consumer}}); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
@@ -0,0 +1,153 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	rd "github.com/go-redis/redis"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/ids"
+	"github.com/emadghaffari/kit-blog/users/pkg/redis"
+)
+
+// States of an export job.
+const (
+	StatusPending = "pending"
+	StatusReady   = "ready"
+	StatusFailed  = "failed"
+)
+
+// ErrNotFound is returned for an unknown or expired job, or a job of
+// another user.
+var ErrNotFound = errors.New("export not found")
+
+// Job is a data export of a user being built in the background.
+type Job struct {
+	ID        string
+	UserID    string
+	Status    string
+	CreatedAt time.Time
+}
+
+// Jobs keeps export jobs and their archives in redis for TTL.
+type Jobs struct {
+	TTL time.Duration
+	IDs ids.Generator
+}
+
+// New returns Jobs kept for ttl.
+func New(ttl time.Duration) *Jobs {
+	return &Jobs{TTL: ttl, IDs: ids.Random{Bytes: 32}}
+}
+
+// Start returns a new pending job for userID, or the one still pending, so
+// repeated requests do not build the archive again. started reports
+// whether the job is new and has to be built.
+func (j *Jobs) Start(ctx context.Context, userID string) (job Job, started bool, err error) {
+	db := redis.DB.GetDB()
+	if id, err := db.Get(ctx, userKey(userID)).Result(); err == nil {
+		if job, err := j.Get(ctx, userID, id); err == nil && job.Status == StatusPending {
+			return job, false, nil
+		}
+	}
+
+	id, err := j.IDs.New()
+	if err != nil {
+		return Job{}, false, err
+	}
+	job = Job{ID: id, UserID: userID, Status: StatusPending, CreatedAt: time.Now().UTC()}
+
+	pipe := db.TxPipeline()
+	pipe.HSet(ctx, jobKey(id), "userID", userID, "status", job.Status, "createdAt", job.CreatedAt.Unix())
+	pipe.Expire(ctx, jobKey(id), j.TTL)
+	pipe.Set(ctx, userKey(userID), id, j.TTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return Job{}, false, err
+	}
+	return job, true, nil
+}
+
+// Get returns the job id of userID.
+func (j *Jobs) Get(ctx context.Context, userID, id string) (Job, error) {
+	vals, err := redis.DB.GetDB().HGetAll(ctx, jobKey(id)).Result()
+	if err != nil {
+		return Job{}, err
+	}
+	if len(vals) == 0 || vals["userID"] != userID {
+		return Job{}, ErrNotFound
+	}
+	created, _ := strconv.ParseInt(vals["createdAt"], 10, 64)
+	return Job{ID: id, UserID: userID, Status: vals["status"], CreatedAt: time.Unix(created, 0).UTC()}, nil
+}
+
+// Finish stores the archive of job id and marks it ready.
+func (j *Jobs) Finish(ctx context.Context, id string, archive []byte) error {
+	pipe := redis.DB.GetDB().TxPipeline()
+	pipe.Set(ctx, archiveKey(id), archive, j.TTL)
+	pipe.HSet(ctx, jobKey(id), "status", StatusReady)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Fail marks job id failed, the user may start another.
+func (j *Jobs) Fail(ctx context.Context, id string) error {
+	return redis.DB.GetDB().HSet(ctx, jobKey(id), "status", StatusFailed).Err()
+}
+
+// Archive returns the ZIP of the ready job id of userID.
+func (j *Jobs) Archive(ctx context.Context, userID, id string) ([]byte, error) {
+	job, err := j.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != StatusReady {
+		return nil, ErrNotFound
+	}
+	archive, err := redis.DB.GetDB().Get(ctx, archiveKey(id)).Bytes()
+	if err == rd.Nil {
+		return nil, ErrNotFound
+	}
+	return archive, err
+}
+
+// Zip packs files, keyed by their name in the archive, into a ZIP.
+func Zip(files map[string][]byte) ([]byte, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	for _, name := range names {
+		f, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func jobKey(id string) string {
+	return "export:job:" + id
+}
+
+func archiveKey(id string) string {
+	return "export:zip:" + id
+}
+
+func userKey(userID string) string {
+	return "export:user:" + userID
+}
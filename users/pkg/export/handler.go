@@ -0,0 +1,48 @@
+package export
+
+import (
+	"net/http"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/auth"
+)
+
+// DownloadPath is where Handler is served.
+const DownloadPath = "/export/download"
+
+// Handler serves the archive of a ready job, named by the "job" query
+// parameter, to the signed in user that owns it.
+func Handler(jobs *Jobs, a auth.Authenticator) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		ctx := auth.HTTPToContext()(r.Context(), r)
+		token := auth.TokenFromContext(ctx)
+		if token == "" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		id, err := a.Authenticate(ctx, token)
+		if err != nil || id.KeyID != "" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		job := r.URL.Query().Get("job")
+		archive, err := jobs.Archive(ctx, id.UserID, job)
+		if err == ErrNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="kit-blog-export.zip"`)
+		w.Header().Set("Cache-Control", "no-store")
+		w.Write(archive)
+	})
+}
@@ -2,12 +2,19 @@ package grpc
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
 	grpc "github.com/go-kit/kit/transport/grpc"
 	context1 "golang.org/x/net/context"
+	grpc1 "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	endpoint "github.com/emadghaffari/kit-blog/users/pkg/endpoint"
 	pb "github.com/emadghaffari/kit-blog/users/pkg/grpc/pb"
+	"github.com/emadghaffari/kit-blog/users/pkg/lockout"
+	"github.com/emadghaffari/kit-blog/users/pkg/model"
 )
 
 func makeLoginHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
@@ -24,10 +31,16 @@ func encodeLoginResponse(_ context.Context, r interface{}) (interface{}, error)
 	if resp.E1 != nil {
 		return &pb.LoginReply{Token: "", Status: pb.LoginReply_Fail}, resp.E1
 	}
+	if resp.Challenge != "" {
+		return &pb.LoginReply{Challenge: resp.Challenge, Status: pb.LoginReply_SecondFactor}, nil
+	}
 	return &pb.LoginReply{Token: resp.S0, Status: pb.LoginReply_Success}, nil
 }
 func (g *grpcServer) Login(ctx context1.Context, req *pb.LoginRequest) (*pb.LoginReply, error) {
 	_, rep, err := g.login.ServeGRPC(ctx, req)
+	if locked, ok := err.(*lockout.LockedError); ok {
+		grpc1.SetHeader(ctx, metadata.Pairs("retry-after", strconv.FormatInt(locked.Seconds(), 10)))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -58,26 +71,774 @@ func (g *grpcServer) Register(ctx context1.Context, req *pb.RegisterRequest) (*p
 	return rep.(*pb.RegisterReply), nil
 }
 
-func makeGetHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
-	return grpc.NewServer(endpoints.GetEndpoint, decodeGetRequest, encodeGetResponse, options...)
+func makeGetPrivateHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.GetPrivateEndpoint, decodeGetPrivateRequest, encodeGetPrivateResponse, options...)
 }
 
-func decodeGetRequest(_ context.Context, r interface{}) (interface{}, error) {
-	req := r.(*pb.GetRequest)
-	return endpoint.GetRequest{Id: req.Id}, nil
+func decodeGetPrivateRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.GetPrivateRequest)
+	return endpoint.GetPrivateRequest{Id: req.Id}, nil
 }
 
-func encodeGetResponse(_ context.Context, r interface{}) (interface{}, error) {
-	resp := r.(endpoint.GetResponse)
+func encodeGetPrivateResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.GetPrivateResponse)
 	if resp.E1 != nil {
-		return &pb.GetReply{Username: "", Email: "", Phone: "", Status: pb.GetReply_Fail}, resp.E1
+		return &pb.GetPrivateReply{Username: "", Email: "", Phone: "", Status: pb.GetPrivateReply_Fail}, resp.E1
+	}
+	return &pb.GetPrivateReply{Username: resp.S0, Email: resp.S1, Phone: resp.S2, Status: pb.GetPrivateReply_Success}, nil
+}
+func (g *grpcServer) GetPrivate(ctx context1.Context, req *pb.GetPrivateRequest) (*pb.GetPrivateReply, error) {
+	_, rep, err := g.getPrivate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetPrivateReply), nil
+}
+
+func makeRequestVerificationHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.RequestVerificationEndpoint, decodeRequestVerificationRequest, encodeRequestVerificationResponse, options...)
+}
+
+func decodeRequestVerificationRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.RequestVerificationRequest)
+	return endpoint.RequestVerificationRequest{Id: req.Id, Channel: strings.ToLower(req.Channel.String())}, nil
+}
+
+func encodeRequestVerificationResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.RequestVerificationResponse)
+	if resp.Err != nil {
+		return &pb.RequestVerificationReply{Status: pb.RequestVerificationReply_Fail}, resp.Err
+	}
+	return &pb.RequestVerificationReply{ExpiresIn: resp.ExpiresIn, Status: pb.RequestVerificationReply_Success}, nil
+}
+func (g *grpcServer) RequestVerification(ctx context1.Context, req *pb.RequestVerificationRequest) (*pb.RequestVerificationReply, error) {
+	_, rep, err := g.requestVerification.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.RequestVerificationReply), nil
+}
+
+func makeConfirmVerificationHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ConfirmVerificationEndpoint, decodeConfirmVerificationRequest, encodeConfirmVerificationResponse, options...)
+}
+
+func decodeConfirmVerificationRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ConfirmVerificationRequest)
+	return endpoint.ConfirmVerificationRequest{Id: req.Id, Channel: strings.ToLower(req.Channel.String()), Code: req.Code}, nil
+}
+
+func encodeConfirmVerificationResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ConfirmVerificationResponse)
+	if resp.Err != nil {
+		return &pb.ConfirmVerificationReply{Status: pb.ConfirmVerificationReply_Fail}, resp.Err
+	}
+	return &pb.ConfirmVerificationReply{Verified: resp.Verified, Status: pb.ConfirmVerificationReply_Success}, nil
+}
+func (g *grpcServer) ConfirmVerification(ctx context1.Context, req *pb.ConfirmVerificationRequest) (*pb.ConfirmVerificationReply, error) {
+	_, rep, err := g.confirmVerification.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ConfirmVerificationReply), nil
+}
+
+func makeAuthenticateHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.AuthenticateEndpoint, decodeAuthenticateRequest, encodeAuthenticateResponse, options...)
+}
+
+func decodeAuthenticateRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.AuthenticateRequest)
+	return endpoint.AuthenticateRequest{Token: req.Token}, nil
+}
+
+func encodeAuthenticateResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.AuthenticateResponse)
+	if resp.Err != nil {
+		return &pb.AuthenticateReply{Status: pb.AuthenticateReply_Fail}, resp.Err
+	}
+	return &pb.AuthenticateReply{Id: resp.Id, Username: resp.Username, Roles: resp.Roles, Scopes: resp.Scopes, KeyID: resp.KeyID, Status: pb.AuthenticateReply_Success}, nil
+}
+func (g *grpcServer) Authenticate(ctx context1.Context, req *pb.AuthenticateRequest) (*pb.AuthenticateReply, error) {
+	_, rep, err := g.authenticate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.AuthenticateReply), nil
+}
+
+func makeRequestPasswordResetHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.RequestPasswordResetEndpoint, decodeRequestPasswordResetRequest, encodeRequestPasswordResetResponse, options...)
+}
+
+func decodeRequestPasswordResetRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.RequestPasswordResetRequest)
+	return endpoint.RequestPasswordResetRequest{Email: req.Email}, nil
+}
+
+func encodeRequestPasswordResetResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.RequestPasswordResetResponse)
+	if resp.Err != nil {
+		return &pb.RequestPasswordResetReply{Status: pb.RequestPasswordResetReply_Fail}, resp.Err
+	}
+	return &pb.RequestPasswordResetReply{Response: resp.Response, Status: pb.RequestPasswordResetReply_Success}, nil
+}
+func (g *grpcServer) RequestPasswordReset(ctx context1.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetReply, error) {
+	_, rep, err := g.requestPasswordReset.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.RequestPasswordResetReply), nil
+}
+
+func makeResetPasswordHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ResetPasswordEndpoint, decodeResetPasswordRequest, encodeResetPasswordResponse, options...)
+}
+
+func decodeResetPasswordRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ResetPasswordRequest)
+	return endpoint.ResetPasswordRequest{Token: req.Token, Password: req.Password}, nil
+}
+
+func encodeResetPasswordResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ResetPasswordResponse)
+	if resp.Err != nil {
+		return &pb.ResetPasswordReply{Status: pb.ResetPasswordReply_Fail}, resp.Err
+	}
+	return &pb.ResetPasswordReply{Response: resp.Response, Status: pb.ResetPasswordReply_Success}, nil
+}
+func (g *grpcServer) ResetPassword(ctx context1.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordReply, error) {
+	_, rep, err := g.resetPassword.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ResetPasswordReply), nil
+}
+
+func makeUpdateProfileHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.UpdateProfileEndpoint, decodeUpdateProfileRequest, encodeUpdateProfileResponse, options...)
+}
+
+func decodeUpdateProfileRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.UpdateProfileRequest)
+	return endpoint.UpdateProfileRequest{Id: req.Id, Username: req.Username, Email: req.Email, Phone: req.Phone}, nil
+}
+
+func encodeUpdateProfileResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.UpdateProfileResponse)
+	if resp.Err != nil {
+		return &pb.UpdateProfileReply{Status: pb.UpdateProfileReply_Fail}, resp.Err
+	}
+	return &pb.UpdateProfileReply{Username: resp.NewUsername, Email: resp.NewEmail, Phone: resp.NewPhone, Status: pb.UpdateProfileReply_Success}, nil
+}
+func (g *grpcServer) UpdateProfile(ctx context1.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileReply, error) {
+	_, rep, err := g.updateProfile.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UpdateProfileReply), nil
+}
+
+func makeChangePasswordHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ChangePasswordEndpoint, decodeChangePasswordRequest, encodeChangePasswordResponse, options...)
+}
+
+func decodeChangePasswordRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ChangePasswordRequest)
+	return endpoint.ChangePasswordRequest{Id: req.Id, Current: req.Current, Password: req.Password}, nil
+}
+
+func encodeChangePasswordResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ChangePasswordResponse)
+	if resp.Err != nil {
+		return &pb.ChangePasswordReply{Status: pb.ChangePasswordReply_Fail}, resp.Err
+	}
+	return &pb.ChangePasswordReply{Response: resp.Response, Status: pb.ChangePasswordReply_Success}, nil
+}
+func (g *grpcServer) ChangePassword(ctx context1.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordReply, error) {
+	_, rep, err := g.changePassword.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ChangePasswordReply), nil
+}
+
+func makeGrantRoleHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.GrantRoleEndpoint, decodeGrantRoleRequest, encodeGrantRoleResponse, options...)
+}
+
+func decodeGrantRoleRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.GrantRoleRequest)
+	return endpoint.GrantRoleRequest{Id: req.Id, Role: req.Role}, nil
+}
+
+func encodeGrantRoleResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.GrantRoleResponse)
+	if resp.Err != nil {
+		return &pb.GrantRoleReply{Status: pb.GrantRoleReply_Fail}, resp.Err
+	}
+	return &pb.GrantRoleReply{Roles: resp.Roles, Status: pb.GrantRoleReply_Success}, nil
+}
+func (g *grpcServer) GrantRole(ctx context1.Context, req *pb.GrantRoleRequest) (*pb.GrantRoleReply, error) {
+	_, rep, err := g.grantRole.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GrantRoleReply), nil
+}
+
+func makeRevokeRoleHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.RevokeRoleEndpoint, decodeRevokeRoleRequest, encodeRevokeRoleResponse, options...)
+}
+
+func decodeRevokeRoleRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.RevokeRoleRequest)
+	return endpoint.RevokeRoleRequest{Id: req.Id, Role: req.Role}, nil
+}
+
+func encodeRevokeRoleResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.RevokeRoleResponse)
+	if resp.Err != nil {
+		return &pb.RevokeRoleReply{Status: pb.RevokeRoleReply_Fail}, resp.Err
+	}
+	return &pb.RevokeRoleReply{Roles: resp.Roles, Status: pb.RevokeRoleReply_Success}, nil
+}
+func (g *grpcServer) RevokeRole(ctx context1.Context, req *pb.RevokeRoleRequest) (*pb.RevokeRoleReply, error) {
+	_, rep, err := g.revokeRole.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.RevokeRoleReply), nil
+}
+
+func makeEnrollTOTPHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.EnrollTOTPEndpoint, decodeEnrollTOTPRequest, encodeEnrollTOTPResponse, options...)
+}
+
+func decodeEnrollTOTPRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.EnrollTOTPRequest)
+	return endpoint.EnrollTOTPRequest{Id: req.Id}, nil
+}
+
+func encodeEnrollTOTPResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.EnrollTOTPResponse)
+	if resp.Err != nil {
+		return &pb.EnrollTOTPReply{Status: pb.EnrollTOTPReply_Fail}, resp.Err
+	}
+	return &pb.EnrollTOTPReply{Secret: resp.Secret, Uri: resp.Uri, Status: pb.EnrollTOTPReply_Success}, nil
+}
+func (g *grpcServer) EnrollTOTP(ctx context1.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPReply, error) {
+	_, rep, err := g.enrollTOTP.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.EnrollTOTPReply), nil
+}
+
+func makeConfirmTOTPHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ConfirmTOTPEndpoint, decodeConfirmTOTPRequest, encodeConfirmTOTPResponse, options...)
+}
+
+func decodeConfirmTOTPRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ConfirmTOTPRequest)
+	return endpoint.ConfirmTOTPRequest{Id: req.Id, Code: req.Code}, nil
+}
+
+func encodeConfirmTOTPResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ConfirmTOTPResponse)
+	if resp.Err != nil {
+		return &pb.ConfirmTOTPReply{Status: pb.ConfirmTOTPReply_Fail}, resp.Err
+	}
+	return &pb.ConfirmTOTPReply{RecoveryCodes: resp.RecoveryCodes, Status: pb.ConfirmTOTPReply_Success}, nil
+}
+func (g *grpcServer) ConfirmTOTP(ctx context1.Context, req *pb.ConfirmTOTPRequest) (*pb.ConfirmTOTPReply, error) {
+	_, rep, err := g.confirmTOTP.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ConfirmTOTPReply), nil
+}
+
+func makeVerifySecondFactorHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.VerifySecondFactorEndpoint, decodeVerifySecondFactorRequest, encodeVerifySecondFactorResponse, options...)
+}
+
+func decodeVerifySecondFactorRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.VerifySecondFactorRequest)
+	return endpoint.VerifySecondFactorRequest{Challenge: req.Challenge, Code: req.Code}, nil
+}
+
+func encodeVerifySecondFactorResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.VerifySecondFactorResponse)
+	if resp.Err != nil {
+		return &pb.VerifySecondFactorReply{Status: pb.VerifySecondFactorReply_Fail}, resp.Err
+	}
+	return &pb.VerifySecondFactorReply{AccessToken: resp.AccessToken, RefreshToken: resp.RefreshToken, Status: pb.VerifySecondFactorReply_Success}, nil
+}
+func (g *grpcServer) VerifySecondFactor(ctx context1.Context, req *pb.VerifySecondFactorRequest) (*pb.VerifySecondFactorReply, error) {
+	_, rep, err := g.verifySecondFactor.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.VerifySecondFactorReply), nil
+}
+
+func makeListSessionsHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ListSessionsEndpoint, decodeListSessionsRequest, encodeListSessionsResponse, options...)
+}
+
+func decodeListSessionsRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ListSessionsRequest)
+	return endpoint.ListSessionsRequest{Id: req.Id}, nil
+}
+
+func encodeListSessionsResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ListSessionsResponse)
+	if resp.Err != nil {
+		return &pb.ListSessionsReply{Status: pb.ListSessionsReply_Fail}, resp.Err
+	}
+	return &pb.ListSessionsReply{Items: toPBSessions(resp.Items), Status: pb.ListSessionsReply_Success}, nil
+}
+func (g *grpcServer) ListSessions(ctx context1.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsReply, error) {
+	_, rep, err := g.listSessions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ListSessionsReply), nil
+}
+
+func makeRevokeSessionHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.RevokeSessionEndpoint, decodeRevokeSessionRequest, encodeRevokeSessionResponse, options...)
+}
+
+func decodeRevokeSessionRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.RevokeSessionRequest)
+	return endpoint.RevokeSessionRequest{Id: req.Id, SessionID: req.SessionID}, nil
+}
+
+func encodeRevokeSessionResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.RevokeSessionResponse)
+	if resp.Err != nil {
+		return &pb.RevokeSessionReply{Status: pb.RevokeSessionReply_Fail}, resp.Err
+	}
+	return &pb.RevokeSessionReply{Response: resp.Response, Status: pb.RevokeSessionReply_Success}, nil
+}
+func (g *grpcServer) RevokeSession(ctx context1.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionReply, error) {
+	_, rep, err := g.revokeSession.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.RevokeSessionReply), nil
+}
+
+func toPBSessions(items []model.Session) []*pb.Session {
+	sessions := make([]*pb.Session, len(items))
+	for i, s := range items {
+		sessions[i] = &pb.Session{
+			Id:         s.ID,
+			UserAgent:  s.UserAgent,
+			Ip:         s.IP,
+			CreatedAt:  s.CreatedAt.Format(time.RFC3339),
+			LastUsedAt: s.LastUsedAt.Format(time.RFC3339),
+			ExpiresAt:  s.ExpiresAt.Format(time.RFC3339),
+		}
+	}
+	return sessions
+}
+
+func makeOIDCLoginHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.OIDCLoginEndpoint, decodeOIDCLoginRequest, encodeOIDCLoginResponse, options...)
+}
+
+func decodeOIDCLoginRequest(_ context.Context, r interface{}) (interface{}, error) {
+	return endpoint.OIDCLoginRequest{}, nil
+}
+
+func encodeOIDCLoginResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.OIDCLoginResponse)
+	if resp.Err != nil {
+		return &pb.OIDCLoginReply{Status: pb.OIDCLoginReply_Fail}, resp.Err
+	}
+	return &pb.OIDCLoginReply{Url: resp.Url, Status: pb.OIDCLoginReply_Success}, nil
+}
+func (g *grpcServer) OIDCLogin(ctx context1.Context, req *pb.OIDCLoginRequest) (*pb.OIDCLoginReply, error) {
+	_, rep, err := g.oIDCLogin.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.OIDCLoginReply), nil
+}
+
+func makeOIDCCallbackHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.OIDCCallbackEndpoint, decodeOIDCCallbackRequest, encodeOIDCCallbackResponse, options...)
+}
+
+func decodeOIDCCallbackRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.OIDCCallbackRequest)
+	return endpoint.OIDCCallbackRequest{State: req.State, Code: req.Code}, nil
+}
+
+func encodeOIDCCallbackResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.OIDCCallbackResponse)
+	if resp.Err != nil {
+		return &pb.OIDCCallbackReply{Status: pb.OIDCCallbackReply_Fail}, resp.Err
+	}
+	return oidcCallbackReply(resp), nil
+}
+func (g *grpcServer) OIDCCallback(ctx context1.Context, req *pb.OIDCCallbackRequest) (*pb.OIDCCallbackReply, error) {
+	_, rep, err := g.oIDCCallback.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.OIDCCallbackReply), nil
+}
+
+func oidcCallbackReply(resp endpoint.OIDCCallbackResponse) *pb.OIDCCallbackReply {
+	if resp.Challenge != "" {
+		return &pb.OIDCCallbackReply{Challenge: resp.Challenge, Status: pb.OIDCCallbackReply_SecondFactor}
+	}
+	return &pb.OIDCCallbackReply{AccessToken: resp.AccessToken, RefreshToken: resp.RefreshToken, Status: pb.OIDCCallbackReply_Success}
+}
+
+func makeCreateAPIKeyHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.CreateAPIKeyEndpoint, decodeCreateAPIKeyRequest, encodeCreateAPIKeyResponse, options...)
+}
+
+func decodeCreateAPIKeyRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.CreateAPIKeyRequest)
+	return endpoint.CreateAPIKeyRequest{Id: req.Id, Name: req.Name, Scopes: req.Scopes, ExpiresIn: req.ExpiresIn}, nil
+}
+
+func encodeCreateAPIKeyResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.CreateAPIKeyResponse)
+	if resp.Err != nil {
+		return &pb.CreateAPIKeyReply{Status: pb.CreateAPIKeyReply_Fail}, resp.Err
+	}
+	return &pb.CreateAPIKeyReply{KeyID: resp.KeyID, Key: resp.Key, Status: pb.CreateAPIKeyReply_Success}, nil
+}
+func (g *grpcServer) CreateAPIKey(ctx context1.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyReply, error) {
+	_, rep, err := g.createAPIKey.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.CreateAPIKeyReply), nil
+}
+
+func makeListAPIKeysHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ListAPIKeysEndpoint, decodeListAPIKeysRequest, encodeListAPIKeysResponse, options...)
+}
+
+func decodeListAPIKeysRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ListAPIKeysRequest)
+	return endpoint.ListAPIKeysRequest{Id: req.Id}, nil
+}
+
+func encodeListAPIKeysResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ListAPIKeysResponse)
+	if resp.Err != nil {
+		return &pb.ListAPIKeysReply{Status: pb.ListAPIKeysReply_Fail}, resp.Err
+	}
+	return &pb.ListAPIKeysReply{Items: toPBAPIKeys(resp.Items), Status: pb.ListAPIKeysReply_Success}, nil
+}
+func (g *grpcServer) ListAPIKeys(ctx context1.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysReply, error) {
+	_, rep, err := g.listAPIKeys.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ListAPIKeysReply), nil
+}
+
+func makeRevokeAPIKeyHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.RevokeAPIKeyEndpoint, decodeRevokeAPIKeyRequest, encodeRevokeAPIKeyResponse, options...)
+}
+
+func decodeRevokeAPIKeyRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.RevokeAPIKeyRequest)
+	return endpoint.RevokeAPIKeyRequest{Id: req.Id, KeyID: req.KeyID}, nil
+}
+
+func encodeRevokeAPIKeyResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.RevokeAPIKeyResponse)
+	if resp.Err != nil {
+		return &pb.RevokeAPIKeyReply{Status: pb.RevokeAPIKeyReply_Fail}, resp.Err
+	}
+	return &pb.RevokeAPIKeyReply{Response: resp.Response, Status: pb.RevokeAPIKeyReply_Success}, nil
+}
+func (g *grpcServer) RevokeAPIKey(ctx context1.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyReply, error) {
+	_, rep, err := g.revokeAPIKey.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.RevokeAPIKeyReply), nil
+}
+
+func toPBAPIKeys(items []model.APIKey) []*pb.APIKey {
+	keys := make([]*pb.APIKey, len(items))
+	for i, k := range items {
+		keys[i] = &pb.APIKey{
+			Id:        k.ID,
+			Name:      k.Name,
+			Prefix:    k.Prefix,
+			Scopes:    k.Scopes,
+			CreatedAt: k.CreatedAt.Format(time.RFC3339),
+			ExpiresAt: k.ExpiresAt.Format(time.RFC3339),
+		}
+		// a key that was never used has no time to show
+		if !k.LastUsedAt.IsZero() {
+			keys[i].LastUsedAt = k.LastUsedAt.Format(time.RFC3339)
+		}
+	}
+	return keys
+}
+
+func toPBFollows(items []model.Follow) []*pb.Follow {
+	follows := make([]*pb.Follow, len(items))
+	for i, f := range items {
+		follows[i] = &pb.Follow{
+			Follower:  f.Follower,
+			Followee:  f.Followee,
+			CreatedAt: f.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return follows
+}
+
+func makeDeleteAccountHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.DeleteAccountEndpoint, decodeDeleteAccountRequest, encodeDeleteAccountResponse, options...)
+}
+
+func decodeDeleteAccountRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.DeleteAccountRequest)
+	return endpoint.DeleteAccountRequest{Id: req.Id, Password: req.Password}, nil
+}
+
+func encodeDeleteAccountResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.DeleteAccountResponse)
+	if resp.Err != nil {
+		return &pb.DeleteAccountReply{Status: pb.DeleteAccountReply_Fail}, resp.Err
+	}
+	return &pb.DeleteAccountReply{DeleteAt: resp.DeleteAt, Status: pb.DeleteAccountReply_Success}, nil
+}
+func (g *grpcServer) DeleteAccount(ctx context1.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountReply, error) {
+	_, rep, err := g.deleteAccount.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.DeleteAccountReply), nil
+}
+
+func makeExportMyDataHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ExportMyDataEndpoint, decodeExportMyDataRequest, encodeExportMyDataResponse, options...)
+}
+
+func decodeExportMyDataRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ExportMyDataRequest)
+	return endpoint.ExportMyDataRequest{Id: req.Id}, nil
+}
+
+func encodeExportMyDataResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ExportMyDataResponse)
+	if resp.Err != nil {
+		return &pb.ExportMyDataReply{Status: pb.ExportMyDataReply_Fail}, resp.Err
+	}
+	return &pb.ExportMyDataReply{JobID: resp.JobID, Status: pb.ExportMyDataReply_Success}, nil
+}
+func (g *grpcServer) ExportMyData(ctx context1.Context, req *pb.ExportMyDataRequest) (*pb.ExportMyDataReply, error) {
+	_, rep, err := g.exportMyData.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ExportMyDataReply), nil
+}
+
+func makeExportStatusHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ExportStatusEndpoint, decodeExportStatusRequest, encodeExportStatusResponse, options...)
+}
+
+func decodeExportStatusRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ExportStatusRequest)
+	return endpoint.ExportStatusRequest{Id: req.Id, JobID: req.JobID}, nil
+}
+
+func encodeExportStatusResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ExportStatusResponse)
+	if resp.Err != nil {
+		return &pb.ExportStatusReply{Status: pb.ExportStatusReply_Fail}, resp.Err
+	}
+	return &pb.ExportStatusReply{State: resp.State, DownloadURL: resp.DownloadURL, Status: pb.ExportStatusReply_Success}, nil
+}
+func (g *grpcServer) ExportStatus(ctx context1.Context, req *pb.ExportStatusRequest) (*pb.ExportStatusReply, error) {
+	_, rep, err := g.exportStatus.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ExportStatusReply), nil
+}
+
+func makeGetPublicProfileHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.GetPublicProfileEndpoint, decodeGetPublicProfileRequest, encodeGetPublicProfileResponse, options...)
+}
+
+func decodeGetPublicProfileRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.GetPublicProfileRequest)
+	return endpoint.GetPublicProfileRequest{Id: req.Id}, nil
+}
+
+func encodeGetPublicProfileResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.GetPublicProfileResponse)
+	if resp.Err != nil {
+		return &pb.GetPublicProfileReply{Status: pb.GetPublicProfileReply_Fail}, resp.Err
+	}
+	return &pb.GetPublicProfileReply{Username: resp.Username, DisplayName: resp.DisplayName, Bio: resp.Bio, AvatarURL: resp.AvatarURL, JoinedAt: resp.JoinedAt, Status: pb.GetPublicProfileReply_Success}, nil
+}
+func (g *grpcServer) GetPublicProfile(ctx context1.Context, req *pb.GetPublicProfileRequest) (*pb.GetPublicProfileReply, error) {
+	_, rep, err := g.getPublicProfile.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.GetPublicProfileReply), nil
+}
+
+func makeUpdatePublicProfileHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.UpdatePublicProfileEndpoint, decodeUpdatePublicProfileRequest, encodeUpdatePublicProfileResponse, options...)
+}
+
+func decodeUpdatePublicProfileRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.UpdatePublicProfileRequest)
+	return endpoint.UpdatePublicProfileRequest{Id: req.Id, DisplayName: req.DisplayName, Bio: req.Bio, AvatarURL: req.AvatarURL}, nil
+}
+
+func encodeUpdatePublicProfileResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.UpdatePublicProfileResponse)
+	if resp.Err != nil {
+		return &pb.UpdatePublicProfileReply{Status: pb.UpdatePublicProfileReply_Fail}, resp.Err
+	}
+	return &pb.UpdatePublicProfileReply{Response: resp.Response, Status: pb.UpdatePublicProfileReply_Success}, nil
+}
+func (g *grpcServer) UpdatePublicProfile(ctx context1.Context, req *pb.UpdatePublicProfileRequest) (*pb.UpdatePublicProfileReply, error) {
+	_, rep, err := g.updatePublicProfile.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UpdatePublicProfileReply), nil
+}
+
+func makeFollowHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.FollowEndpoint, decodeFollowRequest, encodeFollowResponse, options...)
+}
+
+func decodeFollowRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.FollowRequest)
+	return endpoint.FollowRequest{Id: req.Id, AuthorID: req.AuthorID}, nil
+}
+
+func encodeFollowResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.FollowResponse)
+	if resp.Err != nil {
+		return &pb.FollowReply{Status: pb.FollowReply_Fail}, resp.Err
+	}
+	return &pb.FollowReply{Response: resp.Response, Status: pb.FollowReply_Success}, nil
+}
+func (g *grpcServer) Follow(ctx context1.Context, req *pb.FollowRequest) (*pb.FollowReply, error) {
+	_, rep, err := g.follow.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.FollowReply), nil
+}
+
+func makeUnfollowHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.UnfollowEndpoint, decodeUnfollowRequest, encodeUnfollowResponse, options...)
+}
+
+func decodeUnfollowRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.UnfollowRequest)
+	return endpoint.UnfollowRequest{Id: req.Id, AuthorID: req.AuthorID}, nil
+}
+
+func encodeUnfollowResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.UnfollowResponse)
+	if resp.Err != nil {
+		return &pb.UnfollowReply{Status: pb.UnfollowReply_Fail}, resp.Err
+	}
+	return &pb.UnfollowReply{Response: resp.Response, Status: pb.UnfollowReply_Success}, nil
+}
+func (g *grpcServer) Unfollow(ctx context1.Context, req *pb.UnfollowRequest) (*pb.UnfollowReply, error) {
+	_, rep, err := g.unfollow.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UnfollowReply), nil
+}
+
+func makeListFollowersHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ListFollowersEndpoint, decodeListFollowersRequest, encodeListFollowersResponse, options...)
+}
+
+func decodeListFollowersRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ListFollowersRequest)
+	return endpoint.ListFollowersRequest{Id: req.Id, Limit: req.Limit, Cursor: req.Cursor}, nil
+}
+
+func encodeListFollowersResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ListFollowersResponse)
+	if resp.Err != nil {
+		return &pb.ListFollowersReply{Status: pb.ListFollowersReply_Fail}, resp.Err
+	}
+	return &pb.ListFollowersReply{Items: toPBFollows(resp.Items), Total: resp.Total, Next: resp.Next, Status: pb.ListFollowersReply_Success}, nil
+}
+func (g *grpcServer) ListFollowers(ctx context1.Context, req *pb.ListFollowersRequest) (*pb.ListFollowersReply, error) {
+	_, rep, err := g.listFollowers.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ListFollowersReply), nil
+}
+
+func makeListFollowingHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.ListFollowingEndpoint, decodeListFollowingRequest, encodeListFollowingResponse, options...)
+}
+
+func decodeListFollowingRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.ListFollowingRequest)
+	return endpoint.ListFollowingRequest{Id: req.Id, Limit: req.Limit, Cursor: req.Cursor}, nil
+}
+
+func encodeListFollowingResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.ListFollowingResponse)
+	if resp.Err != nil {
+		return &pb.ListFollowingReply{Status: pb.ListFollowingReply_Fail}, resp.Err
+	}
+	return &pb.ListFollowingReply{Items: toPBFollows(resp.Items), Total: resp.Total, Next: resp.Next, Status: pb.ListFollowingReply_Success}, nil
+}
+func (g *grpcServer) ListFollowing(ctx context1.Context, req *pb.ListFollowingRequest) (*pb.ListFollowingReply, error) {
+	_, rep, err := g.listFollowing.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ListFollowingReply), nil
+}
+
+func makeCancelDeletionHandler(endpoints endpoint.Endpoints, options []grpc.ServerOption) grpc.Handler {
+	return grpc.NewServer(endpoints.CancelDeletionEndpoint, decodeCancelDeletionRequest, encodeCancelDeletionResponse, options...)
+}
+
+func decodeCancelDeletionRequest(_ context.Context, r interface{}) (interface{}, error) {
+	req := r.(*pb.CancelDeletionRequest)
+	return endpoint.CancelDeletionRequest{Id: req.Id}, nil
+}
+
+func encodeCancelDeletionResponse(_ context.Context, r interface{}) (interface{}, error) {
+	resp := r.(endpoint.CancelDeletionResponse)
+	if resp.Err != nil {
+		return &pb.CancelDeletionReply{Status: pb.CancelDeletionReply_Fail}, resp.Err
 	}
-	return &pb.GetReply{Username: resp.S0, Email: resp.S1, Phone: resp.S2, Status: pb.GetReply_Success}, nil
+	return &pb.CancelDeletionReply{Response: resp.Response, Status: pb.CancelDeletionReply_Success}, nil
 }
-func (g *grpcServer) Get(ctx context1.Context, req *pb.GetRequest) (*pb.GetReply, error) {
-	_, rep, err := g.get.ServeGRPC(ctx, req)
+func (g *grpcServer) CancelDeletion(ctx context1.Context, req *pb.CancelDeletionRequest) (*pb.CancelDeletionReply, error) {
+	_, rep, err := g.cancelDeletion.ServeGRPC(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return rep.(*pb.GetReply), nil
+	return rep.(*pb.CancelDeletionReply), nil
 }
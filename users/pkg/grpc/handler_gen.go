@@ -10,15 +10,73 @@ import (
 
 // NewGRPCServer makes a set of endpoints available as a gRPC AddServer
 type grpcServer struct {
-	get      grpc.Handler
-	login    grpc.Handler
-	register grpc.Handler
+	getPrivate           grpc.Handler
+	login                grpc.Handler
+	register             grpc.Handler
+	requestVerification  grpc.Handler
+	confirmVerification  grpc.Handler
+	authenticate         grpc.Handler
+	requestPasswordReset grpc.Handler
+	resetPassword        grpc.Handler
+	updateProfile        grpc.Handler
+	changePassword       grpc.Handler
+	grantRole            grpc.Handler
+	revokeRole           grpc.Handler
+	enrollTOTP           grpc.Handler
+	confirmTOTP          grpc.Handler
+	verifySecondFactor   grpc.Handler
+	listSessions         grpc.Handler
+	revokeSession        grpc.Handler
+	oIDCLogin            grpc.Handler
+	oIDCCallback         grpc.Handler
+	createAPIKey         grpc.Handler
+	listAPIKeys          grpc.Handler
+	revokeAPIKey         grpc.Handler
+	deleteAccount        grpc.Handler
+	exportMyData         grpc.Handler
+	exportStatus         grpc.Handler
+	getPublicProfile     grpc.Handler
+	updatePublicProfile  grpc.Handler
+	follow               grpc.Handler
+	unfollow             grpc.Handler
+	listFollowers        grpc.Handler
+	listFollowing        grpc.Handler
+	cancelDeletion       grpc.Handler
 }
 
 func NewGRPCServer(endpoints endpoint.Endpoints, options map[string][]grpc.ServerOption) pb.UsersServer {
 	return &grpcServer{
-		get:      makeGetHandler(endpoints, options["Get"]),
-		login:    makeLoginHandler(endpoints, options["Login"]),
-		register: makeRegisterHandler(endpoints, options["Register"]),
+		authenticate:         makeAuthenticateHandler(endpoints, options["Authenticate"]),
+		cancelDeletion:       makeCancelDeletionHandler(endpoints, options["CancelDeletion"]),
+		changePassword:       makeChangePasswordHandler(endpoints, options["ChangePassword"]),
+		confirmTOTP:          makeConfirmTOTPHandler(endpoints, options["ConfirmTOTP"]),
+		confirmVerification:  makeConfirmVerificationHandler(endpoints, options["ConfirmVerification"]),
+		createAPIKey:         makeCreateAPIKeyHandler(endpoints, options["CreateAPIKey"]),
+		deleteAccount:        makeDeleteAccountHandler(endpoints, options["DeleteAccount"]),
+		enrollTOTP:           makeEnrollTOTPHandler(endpoints, options["EnrollTOTP"]),
+		exportMyData:         makeExportMyDataHandler(endpoints, options["ExportMyData"]),
+		exportStatus:         makeExportStatusHandler(endpoints, options["ExportStatus"]),
+		follow:               makeFollowHandler(endpoints, options["Follow"]),
+		getPrivate:           makeGetPrivateHandler(endpoints, options["GetPrivate"]),
+		getPublicProfile:     makeGetPublicProfileHandler(endpoints, options["GetPublicProfile"]),
+		grantRole:            makeGrantRoleHandler(endpoints, options["GrantRole"]),
+		listAPIKeys:          makeListAPIKeysHandler(endpoints, options["ListAPIKeys"]),
+		listFollowers:        makeListFollowersHandler(endpoints, options["ListFollowers"]),
+		listFollowing:        makeListFollowingHandler(endpoints, options["ListFollowing"]),
+		listSessions:         makeListSessionsHandler(endpoints, options["ListSessions"]),
+		login:                makeLoginHandler(endpoints, options["Login"]),
+		oIDCCallback:         makeOIDCCallbackHandler(endpoints, options["OIDCCallback"]),
+		oIDCLogin:            makeOIDCLoginHandler(endpoints, options["OIDCLogin"]),
+		register:             makeRegisterHandler(endpoints, options["Register"]),
+		requestPasswordReset: makeRequestPasswordResetHandler(endpoints, options["RequestPasswordReset"]),
+		requestVerification:  makeRequestVerificationHandler(endpoints, options["RequestVerification"]),
+		resetPassword:        makeResetPasswordHandler(endpoints, options["ResetPassword"]),
+		revokeAPIKey:         makeRevokeAPIKeyHandler(endpoints, options["RevokeAPIKey"]),
+		revokeRole:           makeRevokeRoleHandler(endpoints, options["RevokeRole"]),
+		revokeSession:        makeRevokeSessionHandler(endpoints, options["RevokeSession"]),
+		unfollow:             makeUnfollowHandler(endpoints, options["Unfollow"]),
+		updateProfile:        makeUpdateProfileHandler(endpoints, options["UpdateProfile"]),
+		updatePublicProfile:  makeUpdatePublicProfileHandler(endpoints, options["UpdatePublicProfile"]),
+		verifySecondFactor:   makeVerifySecondFactorHandler(endpoints, options["VerifySecondFactor"]),
 	}
 }
@@ -32,8 +32,9 @@ const _ = proto.ProtoPackageIsVersion4
 type LoginReply_ReplyType int32
 
 const (
-	LoginReply_Success LoginReply_ReplyType = 0
-	LoginReply_Fail    LoginReply_ReplyType = 1
+	LoginReply_Success      LoginReply_ReplyType = 0
+	LoginReply_Fail         LoginReply_ReplyType = 1
+	LoginReply_SecondFactor LoginReply_ReplyType = 2
 )
 
 // Enum value maps for LoginReply_ReplyType.
@@ -41,10 +42,12 @@ var (
 	LoginReply_ReplyType_name = map[int32]string{
 		0: "Success",
 		1: "Fail",
+		2: "SecondFactor",
 	}
 	LoginReply_ReplyType_value = map[string]int32{
-		"Success": 0,
-		"Fail":    1,
+		"Success":      0,
+		"Fail":         1,
+		"SecondFactor": 2,
 	}
 )
 
@@ -121,535 +124,6298 @@ func (RegisterReply_ReplyType) EnumDescriptor() ([]byte, []int) {
 	return file_users_proto_rawDescGZIP(), []int{3, 0}
 }
 
-type GetReply_ReplyType int32
+type GetPublicProfileReply_ReplyType int32
 
 const (
-	GetReply_Success GetReply_ReplyType = 0
-	GetReply_Fail    GetReply_ReplyType = 1
+	GetPublicProfileReply_Success GetPublicProfileReply_ReplyType = 0
+	GetPublicProfileReply_Fail    GetPublicProfileReply_ReplyType = 1
 )
 
-// Enum value maps for GetReply_ReplyType.
+// Enum value maps for GetPublicProfileReply_ReplyType.
 var (
-	GetReply_ReplyType_name = map[int32]string{
+	GetPublicProfileReply_ReplyType_name = map[int32]string{
 		0: "Success",
 		1: "Fail",
 	}
-	GetReply_ReplyType_value = map[string]int32{
+	GetPublicProfileReply_ReplyType_value = map[string]int32{
 		"Success": 0,
 		"Fail":    1,
 	}
 )
 
-func (x GetReply_ReplyType) Enum() *GetReply_ReplyType {
-	p := new(GetReply_ReplyType)
+func (x GetPublicProfileReply_ReplyType) Enum() *GetPublicProfileReply_ReplyType {
+	p := new(GetPublicProfileReply_ReplyType)
 	*p = x
 	return p
 }
 
-func (x GetReply_ReplyType) String() string {
+func (x GetPublicProfileReply_ReplyType) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (GetReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+func (GetPublicProfileReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
 	return file_users_proto_enumTypes[2].Descriptor()
 }
 
-func (GetReply_ReplyType) Type() protoreflect.EnumType {
+func (GetPublicProfileReply_ReplyType) Type() protoreflect.EnumType {
 	return &file_users_proto_enumTypes[2]
 }
 
-func (x GetReply_ReplyType) Number() protoreflect.EnumNumber {
+func (x GetPublicProfileReply_ReplyType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GetReply_ReplyType.Descriptor instead.
-func (GetReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use GetPublicProfileReply_ReplyType.Descriptor instead.
+func (GetPublicProfileReply_ReplyType) EnumDescriptor() ([]byte, []int) {
 	return file_users_proto_rawDescGZIP(), []int{5, 0}
 }
 
-type LoginRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetPrivateReply_ReplyType int32
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-}
+const (
+	GetPrivateReply_Success GetPrivateReply_ReplyType = 0
+	GetPrivateReply_Fail    GetPrivateReply_ReplyType = 1
+)
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_users_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetPrivateReply_ReplyType.
+var (
+	GetPrivateReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-}
+	GetPrivateReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
 
-func (x *LoginRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetPrivateReply_ReplyType) Enum() *GetPrivateReply_ReplyType {
+	p := new(GetPrivateReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (x GetPrivateReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetPrivateReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[3].Descriptor()
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{0}
+func (GetPrivateReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[3]
 }
 
-func (x *LoginRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+func (x GetPrivateReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoginRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+// Deprecated: Use GetPrivateReply_ReplyType.Descriptor instead.
+func (GetPrivateReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{7, 0}
 }
 
-type LoginReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type RequestVerificationRequest_ChannelType int32
 
-	Token  string               `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Status LoginReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.LoginReply_ReplyType" json:"status,omitempty"`
-}
+const (
+	RequestVerificationRequest_Email RequestVerificationRequest_ChannelType = 0
+	RequestVerificationRequest_Phone RequestVerificationRequest_ChannelType = 1
+)
 
-func (x *LoginReply) Reset() {
-	*x = LoginReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_users_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for RequestVerificationRequest_ChannelType.
+var (
+	RequestVerificationRequest_ChannelType_name = map[int32]string{
+		0: "Email",
+		1: "Phone",
 	}
-}
+	RequestVerificationRequest_ChannelType_value = map[string]int32{
+		"Email": 0,
+		"Phone": 1,
+	}
+)
 
-func (x *LoginReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RequestVerificationRequest_ChannelType) Enum() *RequestVerificationRequest_ChannelType {
+	p := new(RequestVerificationRequest_ChannelType)
+	*p = x
+	return p
 }
 
-func (*LoginReply) ProtoMessage() {}
+func (x RequestVerificationRequest_ChannelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *LoginReply) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RequestVerificationRequest_ChannelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[4].Descriptor()
 }
 
-// Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
-func (*LoginReply) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{1}
+func (RequestVerificationRequest_ChannelType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[4]
 }
 
-func (x *LoginReply) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+func (x RequestVerificationRequest_ChannelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoginReply) GetStatus() LoginReply_ReplyType {
-	if x != nil {
-		return x.Status
-	}
-	return LoginReply_Success
+// Deprecated: Use RequestVerificationRequest_ChannelType.Descriptor instead.
+func (RequestVerificationRequest_ChannelType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{8, 0}
 }
 
-type RegisterRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type RequestVerificationReply_ReplyType int32
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Phone    string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email    string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-}
+const (
+	RequestVerificationReply_Success RequestVerificationReply_ReplyType = 0
+	RequestVerificationReply_Fail    RequestVerificationReply_ReplyType = 1
+)
 
-func (x *RegisterRequest) Reset() {
-	*x = RegisterRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_users_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for RequestVerificationReply_ReplyType.
+var (
+	RequestVerificationReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-}
+	RequestVerificationReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
 
-func (x *RegisterRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RequestVerificationReply_ReplyType) Enum() *RequestVerificationReply_ReplyType {
+	p := new(RequestVerificationReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (*RegisterRequest) ProtoMessage() {}
+func (x RequestVerificationReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RequestVerificationReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[5].Descriptor()
 }
 
-// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{2}
+func (RequestVerificationReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[5]
 }
 
-func (x *RegisterRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+func (x RequestVerificationReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *RegisterRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+// Deprecated: Use RequestVerificationReply_ReplyType.Descriptor instead.
+func (RequestVerificationReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *RegisterRequest) GetPhone() string {
-	if x != nil {
-		return x.Phone
+type ConfirmVerificationReply_ReplyType int32
+
+const (
+	ConfirmVerificationReply_Success ConfirmVerificationReply_ReplyType = 0
+	ConfirmVerificationReply_Fail    ConfirmVerificationReply_ReplyType = 1
+)
+
+// Enum value maps for ConfirmVerificationReply_ReplyType.
+var (
+	ConfirmVerificationReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-	return ""
+	ConfirmVerificationReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ConfirmVerificationReply_ReplyType) Enum() *ConfirmVerificationReply_ReplyType {
+	p := new(ConfirmVerificationReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (x *RegisterRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (x ConfirmVerificationReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type RegisterReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ConfirmVerificationReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[6].Descriptor()
+}
 
-	Token  string                  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Status RegisterReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.RegisterReply_ReplyType" json:"status,omitempty"`
+func (ConfirmVerificationReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[6]
 }
 
-func (x *RegisterReply) Reset() {
-	*x = RegisterReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_users_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ConfirmVerificationReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *RegisterReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ConfirmVerificationReply_ReplyType.Descriptor instead.
+func (ConfirmVerificationReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{11, 0}
 }
 
-func (*RegisterReply) ProtoMessage() {}
+type AuthenticateReply_ReplyType int32
 
-func (x *RegisterReply) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	AuthenticateReply_Success AuthenticateReply_ReplyType = 0
+	AuthenticateReply_Fail    AuthenticateReply_ReplyType = 1
+)
+
+// Enum value maps for AuthenticateReply_ReplyType.
+var (
+	AuthenticateReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-	return mi.MessageOf(x)
-}
+	AuthenticateReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
 
-// Deprecated: Use RegisterReply.ProtoReflect.Descriptor instead.
-func (*RegisterReply) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{3}
+func (x AuthenticateReply_ReplyType) Enum() *AuthenticateReply_ReplyType {
+	p := new(AuthenticateReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (x *RegisterReply) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+func (x AuthenticateReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *RegisterReply) GetStatus() RegisterReply_ReplyType {
-	if x != nil {
-		return x.Status
-	}
-	return RegisterReply_Success
+func (AuthenticateReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[7].Descriptor()
 }
 
-type GetRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (AuthenticateReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[7]
 }
 
-func (x *GetRequest) Reset() {
-	*x = GetRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_users_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x AuthenticateReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use AuthenticateReply_ReplyType.Descriptor instead.
+func (AuthenticateReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{13, 0}
 }
 
-func (*GetRequest) ProtoMessage() {}
+type RequestPasswordResetReply_ReplyType int32
 
-func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	RequestPasswordResetReply_Success RequestPasswordResetReply_ReplyType = 0
+	RequestPasswordResetReply_Fail    RequestPasswordResetReply_ReplyType = 1
+)
+
+// Enum value maps for RequestPasswordResetReply_ReplyType.
+var (
+	RequestPasswordResetReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-	return mi.MessageOf(x)
+	RequestPasswordResetReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x RequestPasswordResetReply_ReplyType) Enum() *RequestPasswordResetReply_ReplyType {
+	p := new(RequestPasswordResetReply_ReplyType)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
-func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{4}
+func (x RequestPasswordResetReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (RequestPasswordResetReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[8].Descriptor()
 }
 
-type GetReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (RequestPasswordResetReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[8]
+}
 
-	Username string             `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Phone    string             `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email    string             `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Status   GetReply_ReplyType `protobuf:"varint,4,opt,name=status,proto3,enum=pb.GetReply_ReplyType" json:"status,omitempty"`
+func (x RequestPasswordResetReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetReply) Reset() {
-	*x = GetReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_users_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Use RequestPasswordResetReply_ReplyType.Descriptor instead.
+func (RequestPasswordResetReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type ResetPasswordReply_ReplyType int32
+
+const (
+	ResetPasswordReply_Success ResetPasswordReply_ReplyType = 0
+	ResetPasswordReply_Fail    ResetPasswordReply_ReplyType = 1
+)
+
+// Enum value maps for ResetPasswordReply_ReplyType.
+var (
+	ResetPasswordReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ResetPasswordReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
 	}
+)
+
+func (x ResetPasswordReply_ReplyType) Enum() *ResetPasswordReply_ReplyType {
+	p := new(ResetPasswordReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (x *GetReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ResetPasswordReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetReply) ProtoMessage() {}
+func (ResetPasswordReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[9].Descriptor()
+}
 
-func (x *GetReply) ProtoReflect() protoreflect.Message {
-	mi := &file_users_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ResetPasswordReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[9]
 }
 
-// Deprecated: Use GetReply.ProtoReflect.Descriptor instead.
-func (*GetReply) Descriptor() ([]byte, []int) {
-	return file_users_proto_rawDescGZIP(), []int{5}
+func (x ResetPasswordReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetReply) GetUsername() string {
-	if x != nil {
-		return x.Username
+// Deprecated: Use ResetPasswordReply_ReplyType.Descriptor instead.
+func (ResetPasswordReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type UpdateProfileReply_ReplyType int32
+
+const (
+	UpdateProfileReply_Success UpdateProfileReply_ReplyType = 0
+	UpdateProfileReply_Fail    UpdateProfileReply_ReplyType = 1
+)
+
+// Enum value maps for UpdateProfileReply_ReplyType.
+var (
+	UpdateProfileReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-	return ""
+	UpdateProfileReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x UpdateProfileReply_ReplyType) Enum() *UpdateProfileReply_ReplyType {
+	p := new(UpdateProfileReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (x *GetReply) GetPhone() string {
-	if x != nil {
-		return x.Phone
+func (x UpdateProfileReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateProfileReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[10].Descriptor()
+}
+
+func (UpdateProfileReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[10]
+}
+
+func (x UpdateProfileReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateProfileReply_ReplyType.Descriptor instead.
+func (UpdateProfileReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type ChangePasswordReply_ReplyType int32
+
+const (
+	ChangePasswordReply_Success ChangePasswordReply_ReplyType = 0
+	ChangePasswordReply_Fail    ChangePasswordReply_ReplyType = 1
+)
+
+// Enum value maps for ChangePasswordReply_ReplyType.
+var (
+	ChangePasswordReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-	return ""
+	ChangePasswordReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ChangePasswordReply_ReplyType) Enum() *ChangePasswordReply_ReplyType {
+	p := new(ChangePasswordReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (x *GetReply) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (x ChangePasswordReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangePasswordReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[11].Descriptor()
+}
+
+func (ChangePasswordReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[11]
+}
+
+func (x ChangePasswordReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangePasswordReply_ReplyType.Descriptor instead.
+func (ChangePasswordReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{21, 0}
+}
+
+type GrantRoleReply_ReplyType int32
+
+const (
+	GrantRoleReply_Success GrantRoleReply_ReplyType = 0
+	GrantRoleReply_Fail    GrantRoleReply_ReplyType = 1
+)
+
+// Enum value maps for GrantRoleReply_ReplyType.
+var (
+	GrantRoleReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-	return ""
+	GrantRoleReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x GrantRoleReply_ReplyType) Enum() *GrantRoleReply_ReplyType {
+	p := new(GrantRoleReply_ReplyType)
+	*p = x
+	return p
 }
 
-func (x *GetReply) GetStatus() GetReply_ReplyType {
-	if x != nil {
-		return x.Status
+func (x GrantRoleReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GrantRoleReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[12].Descriptor()
+}
+
+func (GrantRoleReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[12]
+}
+
+func (x GrantRoleReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GrantRoleReply_ReplyType.Descriptor instead.
+func (GrantRoleReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{23, 0}
+}
+
+type RevokeRoleReply_ReplyType int32
+
+const (
+	RevokeRoleReply_Success RevokeRoleReply_ReplyType = 0
+	RevokeRoleReply_Fail    RevokeRoleReply_ReplyType = 1
+)
+
+// Enum value maps for RevokeRoleReply_ReplyType.
+var (
+	RevokeRoleReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-	return GetReply_Success
+	RevokeRoleReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x RevokeRoleReply_ReplyType) Enum() *RevokeRoleReply_ReplyType {
+	p := new(RevokeRoleReply_ReplyType)
+	*p = x
+	return p
 }
 
-var File_users_proto protoreflect.FileDescriptor
+func (x RevokeRoleReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-var file_users_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
-	0x62, 0x22, 0x46, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x78, 0x0a, 0x0a, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69,
-	0x6c, 0x10, 0x01, 0x22, 0x75, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x7e, 0x0a, 0x0d, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00,
-	0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a,
-	0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10,
-	0x01, 0x32, 0x8b, 0x01, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x03, 0x47, 0x65,
-	0x74, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (RevokeRoleReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[13].Descriptor()
+}
+
+func (RevokeRoleReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[13]
 }
 
+func (x RevokeRoleReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevokeRoleReply_ReplyType.Descriptor instead.
+func (RevokeRoleReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{25, 0}
+}
+
+type EnrollTOTPReply_ReplyType int32
+
+const (
+	EnrollTOTPReply_Success EnrollTOTPReply_ReplyType = 0
+	EnrollTOTPReply_Fail    EnrollTOTPReply_ReplyType = 1
+)
+
+// Enum value maps for EnrollTOTPReply_ReplyType.
 var (
-	file_users_proto_rawDescOnce sync.Once
-	file_users_proto_rawDescData = file_users_proto_rawDesc
+	EnrollTOTPReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	EnrollTOTPReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
 )
 
-func file_users_proto_rawDescGZIP() []byte {
-	file_users_proto_rawDescOnce.Do(func() {
-		file_users_proto_rawDescData = protoimpl.X.CompressGZIP(file_users_proto_rawDescData)
-	})
-	return file_users_proto_rawDescData
+func (x EnrollTOTPReply_ReplyType) Enum() *EnrollTOTPReply_ReplyType {
+	p := new(EnrollTOTPReply_ReplyType)
+	*p = x
+	return p
 }
 
-var file_users_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_users_proto_goTypes = []interface{}{
-	(LoginReply_ReplyType)(0),    // 0: pb.LoginReply.ReplyType
-	(RegisterReply_ReplyType)(0), // 1: pb.RegisterReply.ReplyType
-	(GetReply_ReplyType)(0),      // 2: pb.GetReply.ReplyType
-	(*LoginRequest)(nil),         // 3: pb.LoginRequest
-	(*LoginReply)(nil),           // 4: pb.LoginReply
-	(*RegisterRequest)(nil),      // 5: pb.RegisterRequest
-	(*RegisterReply)(nil),        // 6: pb.RegisterReply
-	(*GetRequest)(nil),           // 7: pb.GetRequest
-	(*GetReply)(nil),             // 8: pb.GetReply
+func (x EnrollTOTPReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
-var file_users_proto_depIdxs = []int32{
-	0, // 0: pb.LoginReply.status:type_name -> pb.LoginReply.ReplyType
-	1, // 1: pb.RegisterReply.status:type_name -> pb.RegisterReply.ReplyType
-	2, // 2: pb.GetReply.status:type_name -> pb.GetReply.ReplyType
-	3, // 3: pb.Users.Login:input_type -> pb.LoginRequest
-	5, // 4: pb.Users.Register:input_type -> pb.RegisterRequest
-	7, // 5: pb.Users.Get:input_type -> pb.GetRequest
-	4, // 6: pb.Users.Login:output_type -> pb.LoginReply
-	6, // 7: pb.Users.Register:output_type -> pb.RegisterReply
-	8, // 8: pb.Users.Get:output_type -> pb.GetReply
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+
+func (EnrollTOTPReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[14].Descriptor()
 }
 
-func init() { file_users_proto_init() }
-func file_users_proto_init() {
-	if File_users_proto != nil {
-		return
+func (EnrollTOTPReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[14]
+}
+
+func (x EnrollTOTPReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnrollTOTPReply_ReplyType.Descriptor instead.
+func (EnrollTOTPReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type ConfirmTOTPReply_ReplyType int32
+
+const (
+	ConfirmTOTPReply_Success ConfirmTOTPReply_ReplyType = 0
+	ConfirmTOTPReply_Fail    ConfirmTOTPReply_ReplyType = 1
+)
+
+// Enum value maps for ConfirmTOTPReply_ReplyType.
+var (
+	ConfirmTOTPReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_users_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_users_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_users_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+	ConfirmTOTPReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ConfirmTOTPReply_ReplyType) Enum() *ConfirmTOTPReply_ReplyType {
+	p := new(ConfirmTOTPReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ConfirmTOTPReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfirmTOTPReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[15].Descriptor()
+}
+
+func (ConfirmTOTPReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[15]
+}
+
+func (x ConfirmTOTPReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfirmTOTPReply_ReplyType.Descriptor instead.
+func (ConfirmTOTPReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{29, 0}
+}
+
+type VerifySecondFactorReply_ReplyType int32
+
+const (
+	VerifySecondFactorReply_Success VerifySecondFactorReply_ReplyType = 0
+	VerifySecondFactorReply_Fail    VerifySecondFactorReply_ReplyType = 1
+)
+
+// Enum value maps for VerifySecondFactorReply_ReplyType.
+var (
+	VerifySecondFactorReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	VerifySecondFactorReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x VerifySecondFactorReply_ReplyType) Enum() *VerifySecondFactorReply_ReplyType {
+	p := new(VerifySecondFactorReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x VerifySecondFactorReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerifySecondFactorReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[16].Descriptor()
+}
+
+func (VerifySecondFactorReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[16]
+}
+
+func (x VerifySecondFactorReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerifySecondFactorReply_ReplyType.Descriptor instead.
+func (VerifySecondFactorReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type ListSessionsReply_ReplyType int32
+
+const (
+	ListSessionsReply_Success ListSessionsReply_ReplyType = 0
+	ListSessionsReply_Fail    ListSessionsReply_ReplyType = 1
+)
+
+// Enum value maps for ListSessionsReply_ReplyType.
+var (
+	ListSessionsReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ListSessionsReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ListSessionsReply_ReplyType) Enum() *ListSessionsReply_ReplyType {
+	p := new(ListSessionsReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ListSessionsReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListSessionsReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[17].Descriptor()
+}
+
+func (ListSessionsReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[17]
+}
+
+func (x ListSessionsReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListSessionsReply_ReplyType.Descriptor instead.
+func (ListSessionsReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{34, 0}
+}
+
+type RevokeSessionReply_ReplyType int32
+
+const (
+	RevokeSessionReply_Success RevokeSessionReply_ReplyType = 0
+	RevokeSessionReply_Fail    RevokeSessionReply_ReplyType = 1
+)
+
+// Enum value maps for RevokeSessionReply_ReplyType.
+var (
+	RevokeSessionReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	RevokeSessionReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x RevokeSessionReply_ReplyType) Enum() *RevokeSessionReply_ReplyType {
+	p := new(RevokeSessionReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x RevokeSessionReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevokeSessionReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[18].Descriptor()
+}
+
+func (RevokeSessionReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[18]
+}
+
+func (x RevokeSessionReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevokeSessionReply_ReplyType.Descriptor instead.
+func (RevokeSessionReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{36, 0}
+}
+
+type OIDCLoginReply_ReplyType int32
+
+const (
+	OIDCLoginReply_Success OIDCLoginReply_ReplyType = 0
+	OIDCLoginReply_Fail    OIDCLoginReply_ReplyType = 1
+)
+
+// Enum value maps for OIDCLoginReply_ReplyType.
+var (
+	OIDCLoginReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	OIDCLoginReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x OIDCLoginReply_ReplyType) Enum() *OIDCLoginReply_ReplyType {
+	p := new(OIDCLoginReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x OIDCLoginReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OIDCLoginReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[19].Descriptor()
+}
+
+func (OIDCLoginReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[19]
+}
+
+func (x OIDCLoginReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OIDCLoginReply_ReplyType.Descriptor instead.
+func (OIDCLoginReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{38, 0}
+}
+
+type OIDCCallbackReply_ReplyType int32
+
+const (
+	OIDCCallbackReply_Success      OIDCCallbackReply_ReplyType = 0
+	OIDCCallbackReply_Fail         OIDCCallbackReply_ReplyType = 1
+	OIDCCallbackReply_SecondFactor OIDCCallbackReply_ReplyType = 2
+)
+
+// Enum value maps for OIDCCallbackReply_ReplyType.
+var (
+	OIDCCallbackReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+		2: "SecondFactor",
+	}
+	OIDCCallbackReply_ReplyType_value = map[string]int32{
+		"Success":      0,
+		"Fail":         1,
+		"SecondFactor": 2,
+	}
+)
+
+func (x OIDCCallbackReply_ReplyType) Enum() *OIDCCallbackReply_ReplyType {
+	p := new(OIDCCallbackReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x OIDCCallbackReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OIDCCallbackReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[20].Descriptor()
+}
+
+func (OIDCCallbackReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[20]
+}
+
+func (x OIDCCallbackReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OIDCCallbackReply_ReplyType.Descriptor instead.
+func (OIDCCallbackReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{40, 0}
+}
+
+type CreateAPIKeyReply_ReplyType int32
+
+const (
+	CreateAPIKeyReply_Success CreateAPIKeyReply_ReplyType = 0
+	CreateAPIKeyReply_Fail    CreateAPIKeyReply_ReplyType = 1
+)
+
+// Enum value maps for CreateAPIKeyReply_ReplyType.
+var (
+	CreateAPIKeyReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	CreateAPIKeyReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x CreateAPIKeyReply_ReplyType) Enum() *CreateAPIKeyReply_ReplyType {
+	p := new(CreateAPIKeyReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x CreateAPIKeyReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateAPIKeyReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[21].Descriptor()
+}
+
+func (CreateAPIKeyReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[21]
+}
+
+func (x CreateAPIKeyReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateAPIKeyReply_ReplyType.Descriptor instead.
+func (CreateAPIKeyReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{42, 0}
+}
+
+type ListAPIKeysReply_ReplyType int32
+
+const (
+	ListAPIKeysReply_Success ListAPIKeysReply_ReplyType = 0
+	ListAPIKeysReply_Fail    ListAPIKeysReply_ReplyType = 1
+)
+
+// Enum value maps for ListAPIKeysReply_ReplyType.
+var (
+	ListAPIKeysReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ListAPIKeysReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ListAPIKeysReply_ReplyType) Enum() *ListAPIKeysReply_ReplyType {
+	p := new(ListAPIKeysReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ListAPIKeysReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListAPIKeysReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[22].Descriptor()
+}
+
+func (ListAPIKeysReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[22]
+}
+
+func (x ListAPIKeysReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListAPIKeysReply_ReplyType.Descriptor instead.
+func (ListAPIKeysReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{45, 0}
+}
+
+type RevokeAPIKeyReply_ReplyType int32
+
+const (
+	RevokeAPIKeyReply_Success RevokeAPIKeyReply_ReplyType = 0
+	RevokeAPIKeyReply_Fail    RevokeAPIKeyReply_ReplyType = 1
+)
+
+// Enum value maps for RevokeAPIKeyReply_ReplyType.
+var (
+	RevokeAPIKeyReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	RevokeAPIKeyReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x RevokeAPIKeyReply_ReplyType) Enum() *RevokeAPIKeyReply_ReplyType {
+	p := new(RevokeAPIKeyReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x RevokeAPIKeyReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevokeAPIKeyReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[23].Descriptor()
+}
+
+func (RevokeAPIKeyReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[23]
+}
+
+func (x RevokeAPIKeyReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevokeAPIKeyReply_ReplyType.Descriptor instead.
+func (RevokeAPIKeyReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{47, 0}
+}
+
+type DeleteAccountReply_ReplyType int32
+
+const (
+	DeleteAccountReply_Success DeleteAccountReply_ReplyType = 0
+	DeleteAccountReply_Fail    DeleteAccountReply_ReplyType = 1
+)
+
+// Enum value maps for DeleteAccountReply_ReplyType.
+var (
+	DeleteAccountReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	DeleteAccountReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x DeleteAccountReply_ReplyType) Enum() *DeleteAccountReply_ReplyType {
+	p := new(DeleteAccountReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x DeleteAccountReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteAccountReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[24].Descriptor()
+}
+
+func (DeleteAccountReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[24]
+}
+
+func (x DeleteAccountReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteAccountReply_ReplyType.Descriptor instead.
+func (DeleteAccountReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{49, 0}
+}
+
+type CancelDeletionReply_ReplyType int32
+
+const (
+	CancelDeletionReply_Success CancelDeletionReply_ReplyType = 0
+	CancelDeletionReply_Fail    CancelDeletionReply_ReplyType = 1
+)
+
+// Enum value maps for CancelDeletionReply_ReplyType.
+var (
+	CancelDeletionReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	CancelDeletionReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x CancelDeletionReply_ReplyType) Enum() *CancelDeletionReply_ReplyType {
+	p := new(CancelDeletionReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x CancelDeletionReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancelDeletionReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[25].Descriptor()
+}
+
+func (CancelDeletionReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[25]
+}
+
+func (x CancelDeletionReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancelDeletionReply_ReplyType.Descriptor instead.
+func (CancelDeletionReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{51, 0}
+}
+
+type ExportMyDataReply_ReplyType int32
+
+const (
+	ExportMyDataReply_Success ExportMyDataReply_ReplyType = 0
+	ExportMyDataReply_Fail    ExportMyDataReply_ReplyType = 1
+)
+
+// Enum value maps for ExportMyDataReply_ReplyType.
+var (
+	ExportMyDataReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ExportMyDataReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ExportMyDataReply_ReplyType) Enum() *ExportMyDataReply_ReplyType {
+	p := new(ExportMyDataReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ExportMyDataReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportMyDataReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[26].Descriptor()
+}
+
+func (ExportMyDataReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[26]
+}
+
+func (x ExportMyDataReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportMyDataReply_ReplyType.Descriptor instead.
+func (ExportMyDataReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{53, 0}
+}
+
+type ExportStatusReply_ReplyType int32
+
+const (
+	ExportStatusReply_Success ExportStatusReply_ReplyType = 0
+	ExportStatusReply_Fail    ExportStatusReply_ReplyType = 1
+)
+
+// Enum value maps for ExportStatusReply_ReplyType.
+var (
+	ExportStatusReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ExportStatusReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ExportStatusReply_ReplyType) Enum() *ExportStatusReply_ReplyType {
+	p := new(ExportStatusReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ExportStatusReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportStatusReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[27].Descriptor()
+}
+
+func (ExportStatusReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[27]
+}
+
+func (x ExportStatusReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportStatusReply_ReplyType.Descriptor instead.
+func (ExportStatusReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{55, 0}
+}
+
+type UpdatePublicProfileReply_ReplyType int32
+
+const (
+	UpdatePublicProfileReply_Success UpdatePublicProfileReply_ReplyType = 0
+	UpdatePublicProfileReply_Fail    UpdatePublicProfileReply_ReplyType = 1
+)
+
+// Enum value maps for UpdatePublicProfileReply_ReplyType.
+var (
+	UpdatePublicProfileReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	UpdatePublicProfileReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x UpdatePublicProfileReply_ReplyType) Enum() *UpdatePublicProfileReply_ReplyType {
+	p := new(UpdatePublicProfileReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x UpdatePublicProfileReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdatePublicProfileReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[28].Descriptor()
+}
+
+func (UpdatePublicProfileReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[28]
+}
+
+func (x UpdatePublicProfileReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdatePublicProfileReply_ReplyType.Descriptor instead.
+func (UpdatePublicProfileReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{57, 0}
+}
+
+type FollowReply_ReplyType int32
+
+const (
+	FollowReply_Success FollowReply_ReplyType = 0
+	FollowReply_Fail    FollowReply_ReplyType = 1
+)
+
+// Enum value maps for FollowReply_ReplyType.
+var (
+	FollowReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	FollowReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x FollowReply_ReplyType) Enum() *FollowReply_ReplyType {
+	p := new(FollowReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x FollowReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[29].Descriptor()
+}
+
+func (FollowReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[29]
+}
+
+func (x FollowReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowReply_ReplyType.Descriptor instead.
+func (FollowReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{59, 0}
+}
+
+type UnfollowReply_ReplyType int32
+
+const (
+	UnfollowReply_Success UnfollowReply_ReplyType = 0
+	UnfollowReply_Fail    UnfollowReply_ReplyType = 1
+)
+
+// Enum value maps for UnfollowReply_ReplyType.
+var (
+	UnfollowReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	UnfollowReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x UnfollowReply_ReplyType) Enum() *UnfollowReply_ReplyType {
+	p := new(UnfollowReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x UnfollowReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnfollowReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[30].Descriptor()
+}
+
+func (UnfollowReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[30]
+}
+
+func (x UnfollowReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnfollowReply_ReplyType.Descriptor instead.
+func (UnfollowReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{61, 0}
+}
+
+type ListFollowersReply_ReplyType int32
+
+const (
+	ListFollowersReply_Success ListFollowersReply_ReplyType = 0
+	ListFollowersReply_Fail    ListFollowersReply_ReplyType = 1
+)
+
+// Enum value maps for ListFollowersReply_ReplyType.
+var (
+	ListFollowersReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ListFollowersReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ListFollowersReply_ReplyType) Enum() *ListFollowersReply_ReplyType {
+	p := new(ListFollowersReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ListFollowersReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListFollowersReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[31].Descriptor()
+}
+
+func (ListFollowersReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[31]
+}
+
+func (x ListFollowersReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListFollowersReply_ReplyType.Descriptor instead.
+func (ListFollowersReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{64, 0}
+}
+
+type ListFollowingReply_ReplyType int32
+
+const (
+	ListFollowingReply_Success ListFollowingReply_ReplyType = 0
+	ListFollowingReply_Fail    ListFollowingReply_ReplyType = 1
+)
+
+// Enum value maps for ListFollowingReply_ReplyType.
+var (
+	ListFollowingReply_ReplyType_name = map[int32]string{
+		0: "Success",
+		1: "Fail",
+	}
+	ListFollowingReply_ReplyType_value = map[string]int32{
+		"Success": 0,
+		"Fail":    1,
+	}
+)
+
+func (x ListFollowingReply_ReplyType) Enum() *ListFollowingReply_ReplyType {
+	p := new(ListFollowingReply_ReplyType)
+	*p = x
+	return p
+}
+
+func (x ListFollowingReply_ReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListFollowingReply_ReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_proto_enumTypes[32].Descriptor()
+}
+
+func (ListFollowingReply_ReplyType) Type() protoreflect.EnumType {
+	return &file_users_proto_enumTypes[32]
+}
+
+func (x ListFollowingReply_ReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListFollowingReply_ReplyType.Descriptor instead.
+func (ListFollowingReply_ReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{66, 0}
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  string               `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Status LoginReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.LoginReply_ReplyType" json:"status,omitempty"`
+	// challenge is set with status SecondFactor, VerifySecondFactor exchanges
+	// it and a code for the token
+	Challenge string `protobuf:"bytes,3,opt,name=challenge,proto3" json:"challenge,omitempty"`
+}
+
+func (x *LoginReply) Reset() {
+	*x = LoginReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginReply) ProtoMessage() {}
+
+func (x *LoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
+func (*LoginReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoginReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginReply) GetStatus() LoginReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return LoginReply_Success
+}
+
+func (x *LoginReply) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Phone    string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email    string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RegisterReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  string                  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Status RegisterReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.RegisterReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *RegisterReply) Reset() {
+	*x = RegisterReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterReply) ProtoMessage() {}
+
+func (x *RegisterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterReply.ProtoReflect.Descriptor instead.
+func (*RegisterReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RegisterReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RegisterReply) GetStatus() RegisterReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return RegisterReply_Success
+}
+
+type GetPublicProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetPublicProfileRequest) Reset() {
+	*x = GetPublicProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPublicProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileRequest) ProtoMessage() {}
+
+func (x *GetPublicProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPublicProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPublicProfileReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username    string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	Bio         string `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarURL   string `protobuf:"bytes,4,opt,name=avatarURL,proto3" json:"avatarURL,omitempty"`
+	// joinedAt is RFC3339
+	JoinedAt string                          `protobuf:"bytes,5,opt,name=joinedAt,proto3" json:"joinedAt,omitempty"`
+	Status   GetPublicProfileReply_ReplyType `protobuf:"varint,6,opt,name=status,proto3,enum=pb.GetPublicProfileReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *GetPublicProfileReply) Reset() {
+	*x = GetPublicProfileReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPublicProfileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileReply) ProtoMessage() {}
+
+func (x *GetPublicProfileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileReply.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPublicProfileReply) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetPublicProfileReply) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *GetPublicProfileReply) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *GetPublicProfileReply) GetAvatarURL() string {
+	if x != nil {
+		return x.AvatarURL
+	}
+	return ""
+}
+
+func (x *GetPublicProfileReply) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+func (x *GetPublicProfileReply) GetStatus() GetPublicProfileReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return GetPublicProfileReply_Success
+}
+
+type GetPrivateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetPrivateRequest) Reset() {
+	*x = GetPrivateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrivateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivateRequest) ProtoMessage() {}
+
+func (x *GetPrivateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivateRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivateRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPrivateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPrivateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string                    `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Phone    string                    `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email    string                    `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Status   GetPrivateReply_ReplyType `protobuf:"varint,4,opt,name=status,proto3,enum=pb.GetPrivateReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *GetPrivateReply) Reset() {
+	*x = GetPrivateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrivateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivateReply) ProtoMessage() {}
+
+func (x *GetPrivateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivateReply.ProtoReflect.Descriptor instead.
+func (*GetPrivateReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPrivateReply) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetPrivateReply) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *GetPrivateReply) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GetPrivateReply) GetStatus() GetPrivateReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return GetPrivateReply_Success
+}
+
+type RequestVerificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Channel RequestVerificationRequest_ChannelType `protobuf:"varint,2,opt,name=channel,proto3,enum=pb.RequestVerificationRequest_ChannelType" json:"channel,omitempty"`
+}
+
+func (x *RequestVerificationRequest) Reset() {
+	*x = RequestVerificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestVerificationRequest) ProtoMessage() {}
+
+func (x *RequestVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestVerificationRequest.ProtoReflect.Descriptor instead.
+func (*RequestVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RequestVerificationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RequestVerificationRequest) GetChannel() RequestVerificationRequest_ChannelType {
+	if x != nil {
+		return x.Channel
+	}
+	return RequestVerificationRequest_Email
+}
+
+type RequestVerificationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiresIn int64                              `protobuf:"varint,1,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	Status    RequestVerificationReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.RequestVerificationReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *RequestVerificationReply) Reset() {
+	*x = RequestVerificationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestVerificationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestVerificationReply) ProtoMessage() {}
+
+func (x *RequestVerificationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestVerificationReply.ProtoReflect.Descriptor instead.
+func (*RequestVerificationReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RequestVerificationReply) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *RequestVerificationReply) GetStatus() RequestVerificationReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return RequestVerificationReply_Success
+}
+
+type ConfirmVerificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Channel RequestVerificationRequest_ChannelType `protobuf:"varint,2,opt,name=channel,proto3,enum=pb.RequestVerificationRequest_ChannelType" json:"channel,omitempty"`
+	Code    string                                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ConfirmVerificationRequest) Reset() {
+	*x = ConfirmVerificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmVerificationRequest) ProtoMessage() {}
+
+func (x *ConfirmVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfirmVerificationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfirmVerificationRequest) GetChannel() RequestVerificationRequest_ChannelType {
+	if x != nil {
+		return x.Channel
+	}
+	return RequestVerificationRequest_Email
+}
+
+func (x *ConfirmVerificationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmVerificationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verified bool                               `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	Status   ConfirmVerificationReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ConfirmVerificationReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ConfirmVerificationReply) Reset() {
+	*x = ConfirmVerificationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmVerificationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmVerificationReply) ProtoMessage() {}
+
+func (x *ConfirmVerificationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmVerificationReply.ProtoReflect.Descriptor instead.
+func (*ConfirmVerificationReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConfirmVerificationReply) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *ConfirmVerificationReply) GetStatus() ConfirmVerificationReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ConfirmVerificationReply_Success
+}
+
+type AuthenticateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *AuthenticateRequest) Reset() {
+	*x = AuthenticateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateRequest) ProtoMessage() {}
+
+func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AuthenticateRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AuthenticateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username string                      `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Status   AuthenticateReply_ReplyType `protobuf:"varint,3,opt,name=status,proto3,enum=pb.AuthenticateReply_ReplyType" json:"status,omitempty"`
+	Roles    []string                    `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// scopes and keyID are set when the token is an API key
+	Scopes []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	KeyID  string   `protobuf:"bytes,6,opt,name=keyID,proto3" json:"keyID,omitempty"`
+}
+
+func (x *AuthenticateReply) Reset() {
+	*x = AuthenticateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateReply) ProtoMessage() {}
+
+func (x *AuthenticateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateReply.ProtoReflect.Descriptor instead.
+func (*AuthenticateReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuthenticateReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuthenticateReply) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AuthenticateReply) GetStatus() AuthenticateReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return AuthenticateReply_Success
+}
+
+func (x *AuthenticateReply) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *AuthenticateReply) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *AuthenticateReply) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string                              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status   RequestPasswordResetReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.RequestPasswordResetReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *RequestPasswordResetReply) Reset() {
+	*x = RequestPasswordResetReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPasswordResetReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetReply) ProtoMessage() {}
+
+func (x *RequestPasswordResetReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetReply.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RequestPasswordResetReply) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetReply) GetStatus() RequestPasswordResetReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return RequestPasswordResetReply_Success
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ResetPasswordReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string                       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status   ResetPasswordReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ResetPasswordReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ResetPasswordReply) Reset() {
+	*x = ResetPasswordReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordReply) ProtoMessage() {}
+
+func (x *ResetPasswordReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordReply.ProtoReflect.Descriptor instead.
+func (*ResetPasswordReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResetPasswordReply) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *ResetPasswordReply) GetStatus() ResetPasswordReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ResetPasswordReply_Success
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Phone    string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email    string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type UpdateProfileReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string                       `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Phone    string                       `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email    string                       `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Status   UpdateProfileReply_ReplyType `protobuf:"varint,4,opt,name=status,proto3,enum=pb.UpdateProfileReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *UpdateProfileReply) Reset() {
+	*x = UpdateProfileReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProfileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileReply) ProtoMessage() {}
+
+func (x *UpdateProfileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileReply.ProtoReflect.Descriptor instead.
+func (*UpdateProfileReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateProfileReply) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateProfileReply) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateProfileReply) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateProfileReply) GetStatus() UpdateProfileReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return UpdateProfileReply_Success
+}
+
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Current  string `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChangePasswordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ChangePasswordReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string                        `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status   ChangePasswordReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ChangePasswordReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ChangePasswordReply) Reset() {
+	*x = ChangePasswordReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePasswordReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordReply) ProtoMessage() {}
+
+func (x *ChangePasswordReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordReply.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChangePasswordReply) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *ChangePasswordReply) GetStatus() ChangePasswordReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ChangePasswordReply_Success
+}
+
+type GrantRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *GrantRoleRequest) Reset() {
+	*x = GrantRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRoleRequest) ProtoMessage() {}
+
+func (x *GrantRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRoleRequest.ProtoReflect.Descriptor instead.
+func (*GrantRoleRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GrantRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GrantRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type GrantRoleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles  []string                 `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Status GrantRoleReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.GrantRoleReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *GrantRoleReply) Reset() {
+	*x = GrantRoleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantRoleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRoleReply) ProtoMessage() {}
+
+func (x *GrantRoleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRoleReply.ProtoReflect.Descriptor instead.
+func (*GrantRoleReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GrantRoleReply) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GrantRoleReply) GetStatus() GrantRoleReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return GrantRoleReply_Success
+}
+
+type RevokeRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *RevokeRoleRequest) Reset() {
+	*x = RevokeRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleRequest) ProtoMessage() {}
+
+func (x *RevokeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevokeRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RevokeRoleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles  []string                  `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Status RevokeRoleReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.RevokeRoleReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *RevokeRoleReply) Reset() {
+	*x = RevokeRoleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeRoleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleReply) ProtoMessage() {}
+
+func (x *RevokeRoleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleReply.ProtoReflect.Descriptor instead.
+func (*RevokeRoleReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RevokeRoleReply) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *RevokeRoleReply) GetStatus() RevokeRoleReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return RevokeRoleReply_Success
+}
+
+type EnrollTOTPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EnrollTOTPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EnrollTOTPReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret string                    `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri    string                    `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Status EnrollTOTPReply_ReplyType `protobuf:"varint,3,opt,name=status,proto3,enum=pb.EnrollTOTPReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *EnrollTOTPReply) Reset() {
+	*x = EnrollTOTPReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollTOTPReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPReply) ProtoMessage() {}
+
+func (x *EnrollTOTPReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPReply.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EnrollTOTPReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPReply) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *EnrollTOTPReply) GetStatus() EnrollTOTPReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return EnrollTOTPReply_Success
+}
+
+type ConfirmTOTPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ConfirmTOTPRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string                   `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"`
+	Status        ConfirmTOTPReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ConfirmTOTPReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ConfirmTOTPReply) Reset() {
+	*x = ConfirmTOTPReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmTOTPReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPReply) ProtoMessage() {}
+
+func (x *ConfirmTOTPReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPReply.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ConfirmTOTPReply) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+func (x *ConfirmTOTPReply) GetStatus() ConfirmTOTPReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ConfirmTOTPReply_Success
+}
+
+type VerifySecondFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *VerifySecondFactorRequest) Reset() {
+	*x = VerifySecondFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySecondFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySecondFactorRequest) ProtoMessage() {}
+
+func (x *VerifySecondFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySecondFactorRequest.ProtoReflect.Descriptor instead.
+func (*VerifySecondFactorRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VerifySecondFactorRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *VerifySecondFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifySecondFactorReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string                            `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	RefreshToken string                            `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Status       VerifySecondFactorReply_ReplyType `protobuf:"varint,3,opt,name=status,proto3,enum=pb.VerifySecondFactorReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *VerifySecondFactorReply) Reset() {
+	*x = VerifySecondFactorReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySecondFactorReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySecondFactorReply) ProtoMessage() {}
+
+func (x *VerifySecondFactorReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySecondFactorReply.ProtoReflect.Descriptor instead.
+func (*VerifySecondFactorReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VerifySecondFactorReply) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *VerifySecondFactorReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *VerifySecondFactorReply) GetStatus() VerifySecondFactorReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return VerifySecondFactorReply_Success
+}
+
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent  string `protobuf:"bytes,2,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	Ip         string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	CreatedAt  string `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	LastUsedAt string `protobuf:"bytes,5,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
+	ExpiresAt  string `protobuf:"bytes,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Session) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *Session) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListSessionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSessionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items  []*Session                  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Status ListSessionsReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ListSessionsReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ListSessionsReply) Reset() {
+	*x = ListSessionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsReply) ProtoMessage() {}
+
+func (x *ListSessionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsReply.ProtoReflect.Descriptor instead.
+func (*ListSessionsReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListSessionsReply) GetItems() []*Session {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListSessionsReply) GetStatus() ListSessionsReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ListSessionsReply_Success
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RevokeSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevokeSessionRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type RevokeSessionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string                       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status   RevokeSessionReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.RevokeSessionReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *RevokeSessionReply) Reset() {
+	*x = RevokeSessionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSessionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionReply) ProtoMessage() {}
+
+func (x *RevokeSessionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionReply.ProtoReflect.Descriptor instead.
+func (*RevokeSessionReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RevokeSessionReply) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *RevokeSessionReply) GetStatus() RevokeSessionReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return RevokeSessionReply_Success
+}
+
+type OIDCLoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OIDCLoginRequest) Reset() {
+	*x = OIDCLoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCLoginRequest) ProtoMessage() {}
+
+func (x *OIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCLoginRequest.ProtoReflect.Descriptor instead.
+func (*OIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{37}
+}
+
+type OIDCLoginReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string                   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Status OIDCLoginReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.OIDCLoginReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *OIDCLoginReply) Reset() {
+	*x = OIDCLoginReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCLoginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCLoginReply) ProtoMessage() {}
+
+func (x *OIDCLoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCLoginReply.ProtoReflect.Descriptor instead.
+func (*OIDCLoginReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *OIDCLoginReply) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *OIDCLoginReply) GetStatus() OIDCLoginReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return OIDCLoginReply_Success
+}
+
+type OIDCCallbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *OIDCCallbackRequest) Reset() {
+	*x = OIDCCallbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCCallbackRequest) ProtoMessage() {}
+
+func (x *OIDCCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCCallbackRequest.ProtoReflect.Descriptor instead.
+func (*OIDCCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *OIDCCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OIDCCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type OIDCCallbackReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string                      `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	RefreshToken string                      `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Status       OIDCCallbackReply_ReplyType `protobuf:"varint,3,opt,name=status,proto3,enum=pb.OIDCCallbackReply_ReplyType" json:"status,omitempty"`
+	// challenge is set with status SecondFactor, as in LoginReply
+	Challenge string `protobuf:"bytes,4,opt,name=challenge,proto3" json:"challenge,omitempty"`
+}
+
+func (x *OIDCCallbackReply) Reset() {
+	*x = OIDCCallbackReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCCallbackReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCCallbackReply) ProtoMessage() {}
+
+func (x *OIDCCallbackReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCCallbackReply.ProtoReflect.Descriptor instead.
+func (*OIDCCallbackReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *OIDCCallbackReply) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *OIDCCallbackReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *OIDCCallbackReply) GetStatus() OIDCCallbackReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return OIDCCallbackReply_Success
+}
+
+func (x *OIDCCallbackReply) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// expiresIn is the lifetime of the key in seconds
+	ExpiresIn int64 `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type CreateAPIKeyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyID string `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	// key is shown only in this reply
+	Key    string                      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Status CreateAPIKeyReply_ReplyType `protobuf:"varint,3,opt,name=status,proto3,enum=pb.CreateAPIKeyReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *CreateAPIKeyReply) Reset() {
+	*x = CreateAPIKeyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyReply) ProtoMessage() {}
+
+func (x *CreateAPIKeyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyReply.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateAPIKeyReply) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyReply) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyReply) GetStatus() CreateAPIKeyReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return CreateAPIKeyReply_Success
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix     string   `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes     []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt  string   `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt  string   `protobuf:"bytes,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	LastUsedAt string   `protobuf:"bytes,7,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListAPIKeysRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAPIKeysReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items  []*APIKey                  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Status ListAPIKeysReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ListAPIKeysReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ListAPIKeysReply) Reset() {
+	*x = ListAPIKeysReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysReply) ProtoMessage() {}
+
+func (x *ListAPIKeysReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysReply.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListAPIKeysReply) GetItems() []*APIKey {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAPIKeysReply) GetStatus() ListAPIKeysReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ListAPIKeysReply_Success
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyID string `protobuf:"bytes,2,opt,name=keyID,proto3" json:"keyID,omitempty"`
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RevokeAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+type RevokeAPIKeyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string                      `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status   RevokeAPIKeyReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.RevokeAPIKeyReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *RevokeAPIKeyReply) Reset() {
+	*x = RevokeAPIKeyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAPIKeyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyReply) ProtoMessage() {}
+
+func (x *RevokeAPIKeyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyReply.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RevokeAPIKeyReply) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *RevokeAPIKeyReply) GetStatus() RevokeAPIKeyReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return RevokeAPIKeyReply_Success
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// password confirms the deletion, accounts without one send a two-factor
+	// code or leave it empty after signing in again
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteAccountReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// deleteAt is when the account is removed, RFC 3339
+	DeleteAt string                       `protobuf:"bytes,1,opt,name=deleteAt,proto3" json:"deleteAt,omitempty"`
+	Status   DeleteAccountReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.DeleteAccountReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *DeleteAccountReply) Reset() {
+	*x = DeleteAccountReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountReply) ProtoMessage() {}
+
+func (x *DeleteAccountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountReply.ProtoReflect.Descriptor instead.
+func (*DeleteAccountReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteAccountReply) GetDeleteAt() string {
+	if x != nil {
+		return x.DeleteAt
+	}
+	return ""
+}
+
+func (x *DeleteAccountReply) GetStatus() DeleteAccountReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteAccountReply_Success
+}
+
+type CancelDeletionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelDeletionRequest) Reset() {
+	*x = CancelDeletionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDeletionRequest) ProtoMessage() {}
+
+func (x *CancelDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CancelDeletionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelDeletionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string                        `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status   CancelDeletionReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.CancelDeletionReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *CancelDeletionReply) Reset() {
+	*x = CancelDeletionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDeletionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDeletionReply) ProtoMessage() {}
+
+func (x *CancelDeletionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeletionReply.ProtoReflect.Descriptor instead.
+func (*CancelDeletionReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CancelDeletionReply) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *CancelDeletionReply) GetStatus() CancelDeletionReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return CancelDeletionReply_Success
+}
+
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ExportMyDataRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ExportMyDataReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID  string                      `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	Status ExportMyDataReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.ExportMyDataReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ExportMyDataReply) Reset() {
+	*x = ExportMyDataReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportMyDataReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataReply) ProtoMessage() {}
+
+func (x *ExportMyDataReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataReply.ProtoReflect.Descriptor instead.
+func (*ExportMyDataReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExportMyDataReply) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *ExportMyDataReply) GetStatus() ExportMyDataReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ExportMyDataReply_Success
+}
+
+type ExportStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobID string `protobuf:"bytes,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+}
+
+func (x *ExportStatusRequest) Reset() {
+	*x = ExportStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStatusRequest) ProtoMessage() {}
+
+func (x *ExportStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStatusRequest.ProtoReflect.Descriptor instead.
+func (*ExportStatusRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExportStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportStatusRequest) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+type ExportStatusReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// state is pending, ready or failed
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// downloadURL is set once the state is ready
+	DownloadURL string                      `protobuf:"bytes,2,opt,name=downloadURL,proto3" json:"downloadURL,omitempty"`
+	Status      ExportStatusReply_ReplyType `protobuf:"varint,3,opt,name=status,proto3,enum=pb.ExportStatusReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ExportStatusReply) Reset() {
+	*x = ExportStatusReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStatusReply) ProtoMessage() {}
+
+func (x *ExportStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStatusReply.ProtoReflect.Descriptor instead.
+func (*ExportStatusReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExportStatusReply) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ExportStatusReply) GetDownloadURL() string {
+	if x != nil {
+		return x.DownloadURL
+	}
+	return ""
+}
+
+func (x *ExportStatusReply) GetStatus() ExportStatusReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ExportStatusReply_Success
+}
+
+type UpdatePublicProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	Bio         string `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarURL   string `protobuf:"bytes,4,opt,name=avatarURL,proto3" json:"avatarURL,omitempty"`
+}
+
+func (x *UpdatePublicProfileRequest) Reset() {
+	*x = UpdatePublicProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePublicProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePublicProfileRequest) ProtoMessage() {}
+
+func (x *UpdatePublicProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePublicProfileRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdatePublicProfileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePublicProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdatePublicProfileRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdatePublicProfileRequest) GetAvatarURL() string {
+	if x != nil {
+		return x.AvatarURL
+	}
+	return ""
+}
+
+type UpdatePublicProfileReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string                             `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status   UpdatePublicProfileReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.UpdatePublicProfileReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *UpdatePublicProfileReply) Reset() {
+	*x = UpdatePublicProfileReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePublicProfileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePublicProfileReply) ProtoMessage() {}
+
+func (x *UpdatePublicProfileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePublicProfileReply.ProtoReflect.Descriptor instead.
+func (*UpdatePublicProfileReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpdatePublicProfileReply) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *UpdatePublicProfileReply) GetStatus() UpdatePublicProfileReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return UpdatePublicProfileReply_Success
+}
+
+type FollowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorID string `protobuf:"bytes,2,opt,name=authorID,proto3" json:"authorID,omitempty"`
+}
+
+func (x *FollowRequest) Reset() {
+	*x = FollowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequest) ProtoMessage() {}
+
+func (x *FollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FollowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FollowRequest) GetAuthorID() string {
+	if x != nil {
+		return x.AuthorID
+	}
+	return ""
+}
+
+type FollowReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string                `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status   FollowReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.FollowReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *FollowReply) Reset() {
+	*x = FollowReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowReply) ProtoMessage() {}
+
+func (x *FollowReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowReply.ProtoReflect.Descriptor instead.
+func (*FollowReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FollowReply) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *FollowReply) GetStatus() FollowReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return FollowReply_Success
+}
+
+type UnfollowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorID string `protobuf:"bytes,2,opt,name=authorID,proto3" json:"authorID,omitempty"`
+}
+
+func (x *UnfollowRequest) Reset() {
+	*x = UnfollowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowRequest) ProtoMessage() {}
+
+func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UnfollowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnfollowRequest) GetAuthorID() string {
+	if x != nil {
+		return x.AuthorID
+	}
+	return ""
+}
+
+type UnfollowReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string                  `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Status   UnfollowReply_ReplyType `protobuf:"varint,2,opt,name=status,proto3,enum=pb.UnfollowReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *UnfollowReply) Reset() {
+	*x = UnfollowReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowReply) ProtoMessage() {}
+
+func (x *UnfollowReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowReply.ProtoReflect.Descriptor instead.
+func (*UnfollowReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UnfollowReply) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *UnfollowReply) GetStatus() UnfollowReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return UnfollowReply_Success
+}
+
+// Follow is a user following an author.
+type Follow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Follower  string `protobuf:"bytes,1,opt,name=follower,proto3" json:"follower,omitempty"`
+	Followee  string `protobuf:"bytes,2,opt,name=followee,proto3" json:"followee,omitempty"`
+	CreatedAt string `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *Follow) Reset() {
+	*x = Follow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Follow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Follow) ProtoMessage() {}
+
+func (x *Follow) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Follow.ProtoReflect.Descriptor instead.
+func (*Follow) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Follow) GetFollower() string {
+	if x != nil {
+		return x.Follower
+	}
+	return ""
+}
+
+func (x *Follow) GetFollowee() string {
+	if x != nil {
+		return x.Followee
+	}
+	return ""
+}
+
+func (x *Follow) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListFollowersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor is the next of the previous page, empty for the first one
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListFollowersRequest) Reset() {
+	*x = ListFollowersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersRequest) ProtoMessage() {}
+
+func (x *ListFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListFollowersRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListFollowersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFollowersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListFollowersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Follow `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total int64     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// next is empty on the last page
+	Next   string                       `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+	Status ListFollowersReply_ReplyType `protobuf:"varint,4,opt,name=status,proto3,enum=pb.ListFollowersReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ListFollowersReply) Reset() {
+	*x = ListFollowersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersReply) ProtoMessage() {}
+
+func (x *ListFollowersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersReply.ProtoReflect.Descriptor instead.
+func (*ListFollowersReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListFollowersReply) GetItems() []*Follow {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListFollowersReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFollowersReply) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *ListFollowersReply) GetStatus() ListFollowersReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ListFollowersReply_Success
+}
+
+type ListFollowingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor is the next of the previous page, empty for the first one
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListFollowingRequest) Reset() {
+	*x = ListFollowingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingRequest) ProtoMessage() {}
+
+func (x *ListFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListFollowingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListFollowingRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFollowingRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListFollowingReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Follow `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total int64     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// next is empty on the last page
+	Next   string                       `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+	Status ListFollowingReply_ReplyType `protobuf:"varint,4,opt,name=status,proto3,enum=pb.ListFollowingReply_ReplyType" json:"status,omitempty"`
+}
+
+func (x *ListFollowingReply) Reset() {
+	*x = ListFollowingReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_users_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingReply) ProtoMessage() {}
+
+func (x *ListFollowingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingReply.ProtoReflect.Descriptor instead.
+func (*ListFollowingReply) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListFollowingReply) GetItems() []*Follow {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListFollowingReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFollowingReply) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *ListFollowingReply) GetStatus() ListFollowingReply_ReplyType {
+	if x != nil {
+		return x.Status
+	}
+	return ListFollowingReply_Success
+}
+
+var File_users_proto protoreflect.FileDescriptor
+
+var file_users_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x22, 0x46, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x0a, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x34,
+	0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x10, 0x02, 0x22, 0x75, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x7e, 0x0a, 0x0d, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x29, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52, 0x4c, 0x12, 0x1a,
+	0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x23, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xb4, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x35, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x23, 0x0a, 0x0b,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x10,
+	0x01, 0x22, 0x9c, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x3e, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01,
+	0x22, 0x86, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x44, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xe0, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79,
+	0x49, 0x44, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x33, 0x0a, 0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x9c, 0x01, 0x0a, 0x19,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x48, 0x0a, 0x14, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46,
+	0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x6e, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22,
+	0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c,
+	0x10, 0x01, 0x22, 0x5d, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x90, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61,
+	0x69, 0x6c, 0x10, 0x01, 0x22, 0x36, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x80, 0x01, 0x0a,
+	0x0e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22,
+	0x37, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x23, 0x0a,
+	0x11, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x38, 0x0a, 0x12, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x4d, 0x0a, 0x19,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x17,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3d, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01,
+	0x22, 0xa3, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x93, 0x01,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69,
+	0x6c, 0x10, 0x01, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x12, 0x0a, 0x10, 0x4f, 0x49,
+	0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7c,
+	0x0a, 0x0e, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x3f, 0x0a, 0x13,
+	0x4f, 0x49, 0x44, 0x43, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xe6, 0x01,
+	0x0a, 0x11, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
+	0x49, 0x44, 0x43, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x22, 0x34, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61,
+	0x69, 0x6c, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x10, 0x02, 0x22, 0x6f, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65,
+	0x79, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22,
+	0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c,
+	0x10, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x24, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x3b, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65,
+	0x79, 0x49, 0x44, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22,
+	0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c,
+	0x10, 0x01, 0x22, 0x42, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x90, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69,
+	0x6c, 0x10, 0x01, 0x22, 0x25, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4d, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69,
+	0x6c, 0x10, 0x01, 0x22, 0x3b, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x44,
+	0x22, 0xa8, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x37,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x7e, 0x0a, 0x1a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62,
+	0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x52, 0x4c, 0x22, 0x9a, 0x01, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x3b, 0x0a, 0x0d, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x3d, 0x0a, 0x0f, 0x55, 0x6e, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x55, 0x6e, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x5e,
+	0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x54,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46,
+	0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x54, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xbe, 0x01, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65,
+	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x38,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x32, 0xb1, 0x10, 0x0a,
+	0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x32, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x13, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x38, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x53, 0x0a, 0x13, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x53, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x56, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x41, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x38, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54,
+	0x4f, 0x54, 0x50, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x50, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
+	0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a,
+	0x0c, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x49, 0x44, 0x43,
+	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x44, 0x0a,
+	0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x53, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_users_proto_rawDescOnce sync.Once
+	file_users_proto_rawDescData = file_users_proto_rawDesc
+)
+
+func file_users_proto_rawDescGZIP() []byte {
+	file_users_proto_rawDescOnce.Do(func() {
+		file_users_proto_rawDescData = protoimpl.X.CompressGZIP(file_users_proto_rawDescData)
+	})
+	return file_users_proto_rawDescData
+}
+
+var file_users_proto_enumTypes = make([]protoimpl.EnumInfo, 33)
+var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_users_proto_goTypes = []interface{}{
+	(LoginReply_ReplyType)(0),                   // 0: pb.LoginReply.ReplyType
+	(RegisterReply_ReplyType)(0),                // 1: pb.RegisterReply.ReplyType
+	(GetPublicProfileReply_ReplyType)(0),        // 2: pb.GetPublicProfileReply.ReplyType
+	(GetPrivateReply_ReplyType)(0),              // 3: pb.GetPrivateReply.ReplyType
+	(RequestVerificationRequest_ChannelType)(0), // 4: pb.RequestVerificationRequest.ChannelType
+	(RequestVerificationReply_ReplyType)(0),     // 5: pb.RequestVerificationReply.ReplyType
+	(ConfirmVerificationReply_ReplyType)(0),     // 6: pb.ConfirmVerificationReply.ReplyType
+	(AuthenticateReply_ReplyType)(0),            // 7: pb.AuthenticateReply.ReplyType
+	(RequestPasswordResetReply_ReplyType)(0),    // 8: pb.RequestPasswordResetReply.ReplyType
+	(ResetPasswordReply_ReplyType)(0),           // 9: pb.ResetPasswordReply.ReplyType
+	(UpdateProfileReply_ReplyType)(0),           // 10: pb.UpdateProfileReply.ReplyType
+	(ChangePasswordReply_ReplyType)(0),          // 11: pb.ChangePasswordReply.ReplyType
+	(GrantRoleReply_ReplyType)(0),               // 12: pb.GrantRoleReply.ReplyType
+	(RevokeRoleReply_ReplyType)(0),              // 13: pb.RevokeRoleReply.ReplyType
+	(EnrollTOTPReply_ReplyType)(0),              // 14: pb.EnrollTOTPReply.ReplyType
+	(ConfirmTOTPReply_ReplyType)(0),             // 15: pb.ConfirmTOTPReply.ReplyType
+	(VerifySecondFactorReply_ReplyType)(0),      // 16: pb.VerifySecondFactorReply.ReplyType
+	(ListSessionsReply_ReplyType)(0),            // 17: pb.ListSessionsReply.ReplyType
+	(RevokeSessionReply_ReplyType)(0),           // 18: pb.RevokeSessionReply.ReplyType
+	(OIDCLoginReply_ReplyType)(0),               // 19: pb.OIDCLoginReply.ReplyType
+	(OIDCCallbackReply_ReplyType)(0),            // 20: pb.OIDCCallbackReply.ReplyType
+	(CreateAPIKeyReply_ReplyType)(0),            // 21: pb.CreateAPIKeyReply.ReplyType
+	(ListAPIKeysReply_ReplyType)(0),             // 22: pb.ListAPIKeysReply.ReplyType
+	(RevokeAPIKeyReply_ReplyType)(0),            // 23: pb.RevokeAPIKeyReply.ReplyType
+	(DeleteAccountReply_ReplyType)(0),           // 24: pb.DeleteAccountReply.ReplyType
+	(CancelDeletionReply_ReplyType)(0),          // 25: pb.CancelDeletionReply.ReplyType
+	(ExportMyDataReply_ReplyType)(0),            // 26: pb.ExportMyDataReply.ReplyType
+	(ExportStatusReply_ReplyType)(0),            // 27: pb.ExportStatusReply.ReplyType
+	(UpdatePublicProfileReply_ReplyType)(0),     // 28: pb.UpdatePublicProfileReply.ReplyType
+	(FollowReply_ReplyType)(0),                  // 29: pb.FollowReply.ReplyType
+	(UnfollowReply_ReplyType)(0),                // 30: pb.UnfollowReply.ReplyType
+	(ListFollowersReply_ReplyType)(0),           // 31: pb.ListFollowersReply.ReplyType
+	(ListFollowingReply_ReplyType)(0),           // 32: pb.ListFollowingReply.ReplyType
+	(*LoginRequest)(nil),                        // 33: pb.LoginRequest
+	(*LoginReply)(nil),                          // 34: pb.LoginReply
+	(*RegisterRequest)(nil),                     // 35: pb.RegisterRequest
+	(*RegisterReply)(nil),                       // 36: pb.RegisterReply
+	(*GetPublicProfileRequest)(nil),             // 37: pb.GetPublicProfileRequest
+	(*GetPublicProfileReply)(nil),               // 38: pb.GetPublicProfileReply
+	(*GetPrivateRequest)(nil),                   // 39: pb.GetPrivateRequest
+	(*GetPrivateReply)(nil),                     // 40: pb.GetPrivateReply
+	(*RequestVerificationRequest)(nil),          // 41: pb.RequestVerificationRequest
+	(*RequestVerificationReply)(nil),            // 42: pb.RequestVerificationReply
+	(*ConfirmVerificationRequest)(nil),          // 43: pb.ConfirmVerificationRequest
+	(*ConfirmVerificationReply)(nil),            // 44: pb.ConfirmVerificationReply
+	(*AuthenticateRequest)(nil),                 // 45: pb.AuthenticateRequest
+	(*AuthenticateReply)(nil),                   // 46: pb.AuthenticateReply
+	(*RequestPasswordResetRequest)(nil),         // 47: pb.RequestPasswordResetRequest
+	(*RequestPasswordResetReply)(nil),           // 48: pb.RequestPasswordResetReply
+	(*ResetPasswordRequest)(nil),                // 49: pb.ResetPasswordRequest
+	(*ResetPasswordReply)(nil),                  // 50: pb.ResetPasswordReply
+	(*UpdateProfileRequest)(nil),                // 51: pb.UpdateProfileRequest
+	(*UpdateProfileReply)(nil),                  // 52: pb.UpdateProfileReply
+	(*ChangePasswordRequest)(nil),               // 53: pb.ChangePasswordRequest
+	(*ChangePasswordReply)(nil),                 // 54: pb.ChangePasswordReply
+	(*GrantRoleRequest)(nil),                    // 55: pb.GrantRoleRequest
+	(*GrantRoleReply)(nil),                      // 56: pb.GrantRoleReply
+	(*RevokeRoleRequest)(nil),                   // 57: pb.RevokeRoleRequest
+	(*RevokeRoleReply)(nil),                     // 58: pb.RevokeRoleReply
+	(*EnrollTOTPRequest)(nil),                   // 59: pb.EnrollTOTPRequest
+	(*EnrollTOTPReply)(nil),                     // 60: pb.EnrollTOTPReply
+	(*ConfirmTOTPRequest)(nil),                  // 61: pb.ConfirmTOTPRequest
+	(*ConfirmTOTPReply)(nil),                    // 62: pb.ConfirmTOTPReply
+	(*VerifySecondFactorRequest)(nil),           // 63: pb.VerifySecondFactorRequest
+	(*VerifySecondFactorReply)(nil),             // 64: pb.VerifySecondFactorReply
+	(*Session)(nil),                             // 65: pb.Session
+	(*ListSessionsRequest)(nil),                 // 66: pb.ListSessionsRequest
+	(*ListSessionsReply)(nil),                   // 67: pb.ListSessionsReply
+	(*RevokeSessionRequest)(nil),                // 68: pb.RevokeSessionRequest
+	(*RevokeSessionReply)(nil),                  // 69: pb.RevokeSessionReply
+	(*OIDCLoginRequest)(nil),                    // 70: pb.OIDCLoginRequest
+	(*OIDCLoginReply)(nil),                      // 71: pb.OIDCLoginReply
+	(*OIDCCallbackRequest)(nil),                 // 72: pb.OIDCCallbackRequest
+	(*OIDCCallbackReply)(nil),                   // 73: pb.OIDCCallbackReply
+	(*CreateAPIKeyRequest)(nil),                 // 74: pb.CreateAPIKeyRequest
+	(*CreateAPIKeyReply)(nil),                   // 75: pb.CreateAPIKeyReply
+	(*APIKey)(nil),                              // 76: pb.APIKey
+	(*ListAPIKeysRequest)(nil),                  // 77: pb.ListAPIKeysRequest
+	(*ListAPIKeysReply)(nil),                    // 78: pb.ListAPIKeysReply
+	(*RevokeAPIKeyRequest)(nil),                 // 79: pb.RevokeAPIKeyRequest
+	(*RevokeAPIKeyReply)(nil),                   // 80: pb.RevokeAPIKeyReply
+	(*DeleteAccountRequest)(nil),                // 81: pb.DeleteAccountRequest
+	(*DeleteAccountReply)(nil),                  // 82: pb.DeleteAccountReply
+	(*CancelDeletionRequest)(nil),               // 83: pb.CancelDeletionRequest
+	(*CancelDeletionReply)(nil),                 // 84: pb.CancelDeletionReply
+	(*ExportMyDataRequest)(nil),                 // 85: pb.ExportMyDataRequest
+	(*ExportMyDataReply)(nil),                   // 86: pb.ExportMyDataReply
+	(*ExportStatusRequest)(nil),                 // 87: pb.ExportStatusRequest
+	(*ExportStatusReply)(nil),                   // 88: pb.ExportStatusReply
+	(*UpdatePublicProfileRequest)(nil),          // 89: pb.UpdatePublicProfileRequest
+	(*UpdatePublicProfileReply)(nil),            // 90: pb.UpdatePublicProfileReply
+	(*FollowRequest)(nil),                       // 91: pb.FollowRequest
+	(*FollowReply)(nil),                         // 92: pb.FollowReply
+	(*UnfollowRequest)(nil),                     // 93: pb.UnfollowRequest
+	(*UnfollowReply)(nil),                       // 94: pb.UnfollowReply
+	(*Follow)(nil),                              // 95: pb.Follow
+	(*ListFollowersRequest)(nil),                // 96: pb.ListFollowersRequest
+	(*ListFollowersReply)(nil),                  // 97: pb.ListFollowersReply
+	(*ListFollowingRequest)(nil),                // 98: pb.ListFollowingRequest
+	(*ListFollowingReply)(nil),                  // 99: pb.ListFollowingReply
+}
+var file_users_proto_depIdxs = []int32{
+	0,  // 0: pb.LoginReply.status:type_name -> pb.LoginReply.ReplyType
+	1,  // 1: pb.RegisterReply.status:type_name -> pb.RegisterReply.ReplyType
+	2,  // 2: pb.GetPublicProfileReply.status:type_name -> pb.GetPublicProfileReply.ReplyType
+	3,  // 3: pb.GetPrivateReply.status:type_name -> pb.GetPrivateReply.ReplyType
+	4,  // 4: pb.RequestVerificationRequest.channel:type_name -> pb.RequestVerificationRequest.ChannelType
+	5,  // 5: pb.RequestVerificationReply.status:type_name -> pb.RequestVerificationReply.ReplyType
+	4,  // 6: pb.ConfirmVerificationRequest.channel:type_name -> pb.RequestVerificationRequest.ChannelType
+	6,  // 7: pb.ConfirmVerificationReply.status:type_name -> pb.ConfirmVerificationReply.ReplyType
+	7,  // 8: pb.AuthenticateReply.status:type_name -> pb.AuthenticateReply.ReplyType
+	8,  // 9: pb.RequestPasswordResetReply.status:type_name -> pb.RequestPasswordResetReply.ReplyType
+	9,  // 10: pb.ResetPasswordReply.status:type_name -> pb.ResetPasswordReply.ReplyType
+	10, // 11: pb.UpdateProfileReply.status:type_name -> pb.UpdateProfileReply.ReplyType
+	11, // 12: pb.ChangePasswordReply.status:type_name -> pb.ChangePasswordReply.ReplyType
+	12, // 13: pb.GrantRoleReply.status:type_name -> pb.GrantRoleReply.ReplyType
+	13, // 14: pb.RevokeRoleReply.status:type_name -> pb.RevokeRoleReply.ReplyType
+	14, // 15: pb.EnrollTOTPReply.status:type_name -> pb.EnrollTOTPReply.ReplyType
+	15, // 16: pb.ConfirmTOTPReply.status:type_name -> pb.ConfirmTOTPReply.ReplyType
+	16, // 17: pb.VerifySecondFactorReply.status:type_name -> pb.VerifySecondFactorReply.ReplyType
+	65, // 18: pb.ListSessionsReply.items:type_name -> pb.Session
+	17, // 19: pb.ListSessionsReply.status:type_name -> pb.ListSessionsReply.ReplyType
+	18, // 20: pb.RevokeSessionReply.status:type_name -> pb.RevokeSessionReply.ReplyType
+	19, // 21: pb.OIDCLoginReply.status:type_name -> pb.OIDCLoginReply.ReplyType
+	20, // 22: pb.OIDCCallbackReply.status:type_name -> pb.OIDCCallbackReply.ReplyType
+	21, // 23: pb.CreateAPIKeyReply.status:type_name -> pb.CreateAPIKeyReply.ReplyType
+	76, // 24: pb.ListAPIKeysReply.items:type_name -> pb.APIKey
+	22, // 25: pb.ListAPIKeysReply.status:type_name -> pb.ListAPIKeysReply.ReplyType
+	23, // 26: pb.RevokeAPIKeyReply.status:type_name -> pb.RevokeAPIKeyReply.ReplyType
+	24, // 27: pb.DeleteAccountReply.status:type_name -> pb.DeleteAccountReply.ReplyType
+	25, // 28: pb.CancelDeletionReply.status:type_name -> pb.CancelDeletionReply.ReplyType
+	26, // 29: pb.ExportMyDataReply.status:type_name -> pb.ExportMyDataReply.ReplyType
+	27, // 30: pb.ExportStatusReply.status:type_name -> pb.ExportStatusReply.ReplyType
+	28, // 31: pb.UpdatePublicProfileReply.status:type_name -> pb.UpdatePublicProfileReply.ReplyType
+	29, // 32: pb.FollowReply.status:type_name -> pb.FollowReply.ReplyType
+	30, // 33: pb.UnfollowReply.status:type_name -> pb.UnfollowReply.ReplyType
+	95, // 34: pb.ListFollowersReply.items:type_name -> pb.Follow
+	31, // 35: pb.ListFollowersReply.status:type_name -> pb.ListFollowersReply.ReplyType
+	95, // 36: pb.ListFollowingReply.items:type_name -> pb.Follow
+	32, // 37: pb.ListFollowingReply.status:type_name -> pb.ListFollowingReply.ReplyType
+	33, // 38: pb.Users.Login:input_type -> pb.LoginRequest
+	35, // 39: pb.Users.Register:input_type -> pb.RegisterRequest
+	37, // 40: pb.Users.GetPublicProfile:input_type -> pb.GetPublicProfileRequest
+	39, // 41: pb.Users.GetPrivate:input_type -> pb.GetPrivateRequest
+	41, // 42: pb.Users.RequestVerification:input_type -> pb.RequestVerificationRequest
+	43, // 43: pb.Users.ConfirmVerification:input_type -> pb.ConfirmVerificationRequest
+	45, // 44: pb.Users.Authenticate:input_type -> pb.AuthenticateRequest
+	47, // 45: pb.Users.RequestPasswordReset:input_type -> pb.RequestPasswordResetRequest
+	49, // 46: pb.Users.ResetPassword:input_type -> pb.ResetPasswordRequest
+	51, // 47: pb.Users.UpdateProfile:input_type -> pb.UpdateProfileRequest
+	53, // 48: pb.Users.ChangePassword:input_type -> pb.ChangePasswordRequest
+	55, // 49: pb.Users.GrantRole:input_type -> pb.GrantRoleRequest
+	57, // 50: pb.Users.RevokeRole:input_type -> pb.RevokeRoleRequest
+	59, // 51: pb.Users.EnrollTOTP:input_type -> pb.EnrollTOTPRequest
+	61, // 52: pb.Users.ConfirmTOTP:input_type -> pb.ConfirmTOTPRequest
+	63, // 53: pb.Users.VerifySecondFactor:input_type -> pb.VerifySecondFactorRequest
+	66, // 54: pb.Users.ListSessions:input_type -> pb.ListSessionsRequest
+	68, // 55: pb.Users.RevokeSession:input_type -> pb.RevokeSessionRequest
+	70, // 56: pb.Users.OIDCLogin:input_type -> pb.OIDCLoginRequest
+	72, // 57: pb.Users.OIDCCallback:input_type -> pb.OIDCCallbackRequest
+	74, // 58: pb.Users.CreateAPIKey:input_type -> pb.CreateAPIKeyRequest
+	77, // 59: pb.Users.ListAPIKeys:input_type -> pb.ListAPIKeysRequest
+	79, // 60: pb.Users.RevokeAPIKey:input_type -> pb.RevokeAPIKeyRequest
+	81, // 61: pb.Users.DeleteAccount:input_type -> pb.DeleteAccountRequest
+	83, // 62: pb.Users.CancelDeletion:input_type -> pb.CancelDeletionRequest
+	85, // 63: pb.Users.ExportMyData:input_type -> pb.ExportMyDataRequest
+	87, // 64: pb.Users.ExportStatus:input_type -> pb.ExportStatusRequest
+	89, // 65: pb.Users.UpdatePublicProfile:input_type -> pb.UpdatePublicProfileRequest
+	91, // 66: pb.Users.Follow:input_type -> pb.FollowRequest
+	93, // 67: pb.Users.Unfollow:input_type -> pb.UnfollowRequest
+	96, // 68: pb.Users.ListFollowers:input_type -> pb.ListFollowersRequest
+	98, // 69: pb.Users.ListFollowing:input_type -> pb.ListFollowingRequest
+	34, // 70: pb.Users.Login:output_type -> pb.LoginReply
+	36, // 71: pb.Users.Register:output_type -> pb.RegisterReply
+	38, // 72: pb.Users.GetPublicProfile:output_type -> pb.GetPublicProfileReply
+	40, // 73: pb.Users.GetPrivate:output_type -> pb.GetPrivateReply
+	42, // 74: pb.Users.RequestVerification:output_type -> pb.RequestVerificationReply
+	44, // 75: pb.Users.ConfirmVerification:output_type -> pb.ConfirmVerificationReply
+	46, // 76: pb.Users.Authenticate:output_type -> pb.AuthenticateReply
+	48, // 77: pb.Users.RequestPasswordReset:output_type -> pb.RequestPasswordResetReply
+	50, // 78: pb.Users.ResetPassword:output_type -> pb.ResetPasswordReply
+	52, // 79: pb.Users.UpdateProfile:output_type -> pb.UpdateProfileReply
+	54, // 80: pb.Users.ChangePassword:output_type -> pb.ChangePasswordReply
+	56, // 81: pb.Users.GrantRole:output_type -> pb.GrantRoleReply
+	58, // 82: pb.Users.RevokeRole:output_type -> pb.RevokeRoleReply
+	60, // 83: pb.Users.EnrollTOTP:output_type -> pb.EnrollTOTPReply
+	62, // 84: pb.Users.ConfirmTOTP:output_type -> pb.ConfirmTOTPReply
+	64, // 85: pb.Users.VerifySecondFactor:output_type -> pb.VerifySecondFactorReply
+	67, // 86: pb.Users.ListSessions:output_type -> pb.ListSessionsReply
+	69, // 87: pb.Users.RevokeSession:output_type -> pb.RevokeSessionReply
+	71, // 88: pb.Users.OIDCLogin:output_type -> pb.OIDCLoginReply
+	73, // 89: pb.Users.OIDCCallback:output_type -> pb.OIDCCallbackReply
+	75, // 90: pb.Users.CreateAPIKey:output_type -> pb.CreateAPIKeyReply
+	78, // 91: pb.Users.ListAPIKeys:output_type -> pb.ListAPIKeysReply
+	80, // 92: pb.Users.RevokeAPIKey:output_type -> pb.RevokeAPIKeyReply
+	82, // 93: pb.Users.DeleteAccount:output_type -> pb.DeleteAccountReply
+	84, // 94: pb.Users.CancelDeletion:output_type -> pb.CancelDeletionReply
+	86, // 95: pb.Users.ExportMyData:output_type -> pb.ExportMyDataReply
+	88, // 96: pb.Users.ExportStatus:output_type -> pb.ExportStatusReply
+	90, // 97: pb.Users.UpdatePublicProfile:output_type -> pb.UpdatePublicProfileReply
+	92, // 98: pb.Users.Follow:output_type -> pb.FollowReply
+	94, // 99: pb.Users.Unfollow:output_type -> pb.UnfollowReply
+	97, // 100: pb.Users.ListFollowers:output_type -> pb.ListFollowersReply
+	99, // 101: pb.Users.ListFollowing:output_type -> pb.ListFollowingReply
+	70, // [70:102] is the sub-list for method output_type
+	38, // [38:70] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_users_proto_init() }
+func file_users_proto_init() {
+	if File_users_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_users_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterRequest); i {
 			case 0:
 				return &v.state
@@ -661,8 +6427,452 @@ func file_users_proto_init() {
 				return nil
 			}
 		}
-		file_users_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterReply); i {
+		file_users_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicProfileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestVerificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestVerificationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmVerificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmVerificationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordResetReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProfileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantRoleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeRoleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTOTPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTOTPReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTOTPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTOTPReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySecondFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySecondFactorReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeSessionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCLoginReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCCallbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OIDCCallbackReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -673,8 +6883,8 @@ func file_users_proto_init() {
 				return nil
 			}
 		}
-		file_users_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRequest); i {
+		file_users_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -685,8 +6895,284 @@ func file_users_proto_init() {
 				return nil
 			}
 		}
-		file_users_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReply); i {
+		file_users_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAPIKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAPIKeysReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAPIKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAPIKeyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeletionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeletionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMyDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMyDataReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePublicProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePublicProfileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Follow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowersReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_users_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -697,155 +7183,1097 @@ func file_users_proto_init() {
 				return nil
 			}
 		}
+		file_users_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowingReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_users_proto_rawDesc,
+			NumEnums:      33,
+			NumMessages:   67,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_users_proto_goTypes,
+		DependencyIndexes: file_users_proto_depIdxs,
+		EnumInfos:         file_users_proto_enumTypes,
+		MessageInfos:      file_users_proto_msgTypes,
+	}.Build()
+	File_users_proto = out.File
+	file_users_proto_rawDesc = nil
+	file_users_proto_goTypes = nil
+	file_users_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// UsersClient is the client API for Users service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UsersClient interface {
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error)
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error)
+	GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileReply, error)
+	// GetPrivate returns the contact data of a user, for the blog services only
+	GetPrivate(ctx context.Context, in *GetPrivateRequest, opts ...grpc.CallOption) (*GetPrivateReply, error)
+	RequestVerification(ctx context.Context, in *RequestVerificationRequest, opts ...grpc.CallOption) (*RequestVerificationReply, error)
+	ConfirmVerification(ctx context.Context, in *ConfirmVerificationRequest, opts ...grpc.CallOption) (*ConfirmVerificationReply, error)
+	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateReply, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetReply, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileReply, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordReply, error)
+	GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleReply, error)
+	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleReply, error)
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPReply, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPReply, error)
+	VerifySecondFactor(ctx context.Context, in *VerifySecondFactorRequest, opts ...grpc.CallOption) (*VerifySecondFactorReply, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionReply, error)
+	OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...grpc.CallOption) (*OIDCLoginReply, error)
+	OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*OIDCCallbackReply, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyReply, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysReply, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyReply, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountReply, error)
+	CancelDeletion(ctx context.Context, in *CancelDeletionRequest, opts ...grpc.CallOption) (*CancelDeletionReply, error)
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataReply, error)
+	ExportStatus(ctx context.Context, in *ExportStatusRequest, opts ...grpc.CallOption) (*ExportStatusReply, error)
+	UpdatePublicProfile(ctx context.Context, in *UpdatePublicProfileRequest, opts ...grpc.CallOption) (*UpdatePublicProfileReply, error)
+	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowReply, error)
+	Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowReply, error)
+	ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersReply, error)
+	ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...grpc.CallOption) (*ListFollowingReply, error)
+}
+
+type usersClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUsersClient(cc grpc.ClientConnInterface) UsersClient {
+	return &usersClient{cc}
+}
+
+func (c *usersClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error) {
+	out := new(LoginReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error) {
+	out := new(RegisterReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileReply, error) {
+	out := new(GetPublicProfileReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/GetPublicProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) GetPrivate(ctx context.Context, in *GetPrivateRequest, opts ...grpc.CallOption) (*GetPrivateReply, error) {
+	out := new(GetPrivateReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/GetPrivate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RequestVerification(ctx context.Context, in *RequestVerificationRequest, opts ...grpc.CallOption) (*RequestVerificationReply, error) {
+	out := new(RequestVerificationReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/RequestVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ConfirmVerification(ctx context.Context, in *ConfirmVerificationRequest, opts ...grpc.CallOption) (*ConfirmVerificationReply, error) {
+	out := new(ConfirmVerificationReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ConfirmVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateReply, error) {
+	out := new(AuthenticateReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/Authenticate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetReply, error) {
+	out := new(RequestPasswordResetReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error) {
+	out := new(ResetPasswordReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileReply, error) {
+	out := new(UpdateProfileReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordReply, error) {
+	out := new(ChangePasswordReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleReply, error) {
+	out := new(GrantRoleReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleReply, error) {
+	out := new(RevokeRoleReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPReply, error) {
+	out := new(EnrollTOTPReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/EnrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPReply, error) {
+	out := new(ConfirmTOTPReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) VerifySecondFactor(ctx context.Context, in *VerifySecondFactorRequest, opts ...grpc.CallOption) (*VerifySecondFactorReply, error) {
+	out := new(VerifySecondFactorReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/VerifySecondFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error) {
+	out := new(ListSessionsReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionReply, error) {
+	out := new(RevokeSessionReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...grpc.CallOption) (*OIDCLoginReply, error) {
+	out := new(OIDCLoginReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/OIDCLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*OIDCCallbackReply, error) {
+	out := new(OIDCCallbackReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/OIDCCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyReply, error) {
+	out := new(CreateAPIKeyReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysReply, error) {
+	out := new(ListAPIKeysReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyReply, error) {
+	out := new(RevokeAPIKeyReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountReply, error) {
+	out := new(DeleteAccountReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) CancelDeletion(ctx context.Context, in *CancelDeletionRequest, opts ...grpc.CallOption) (*CancelDeletionReply, error) {
+	out := new(CancelDeletionReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/CancelDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataReply, error) {
+	out := new(ExportMyDataReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ExportMyData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ExportStatus(ctx context.Context, in *ExportStatusRequest, opts ...grpc.CallOption) (*ExportStatusReply, error) {
+	out := new(ExportStatusReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ExportStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) UpdatePublicProfile(ctx context.Context, in *UpdatePublicProfileRequest, opts ...grpc.CallOption) (*UpdatePublicProfileReply, error) {
+	out := new(UpdatePublicProfileReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/UpdatePublicProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowReply, error) {
+	out := new(FollowReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/Follow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowReply, error) {
+	out := new(UnfollowReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/Unfollow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersReply, error) {
+	out := new(ListFollowersReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ListFollowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...grpc.CallOption) (*ListFollowingReply, error) {
+	out := new(ListFollowingReply)
+	err := c.cc.Invoke(ctx, "/pb.Users/ListFollowing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsersServer is the server API for Users service.
+type UsersServer interface {
+	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
+	GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileReply, error)
+	// GetPrivate returns the contact data of a user, for the blog services only
+	GetPrivate(context.Context, *GetPrivateRequest) (*GetPrivateReply, error)
+	RequestVerification(context.Context, *RequestVerificationRequest) (*RequestVerificationReply, error)
+	ConfirmVerification(context.Context, *ConfirmVerificationRequest) (*ConfirmVerificationReply, error)
+	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateReply, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetReply, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileReply, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	GrantRole(context.Context, *GrantRoleRequest) (*GrantRoleReply, error)
+	RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleReply, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPReply, error)
+	VerifySecondFactor(context.Context, *VerifySecondFactorRequest) (*VerifySecondFactorReply, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionReply, error)
+	OIDCLogin(context.Context, *OIDCLoginRequest) (*OIDCLoginReply, error)
+	OIDCCallback(context.Context, *OIDCCallbackRequest) (*OIDCCallbackReply, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyReply, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysReply, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyReply, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountReply, error)
+	CancelDeletion(context.Context, *CancelDeletionRequest) (*CancelDeletionReply, error)
+	ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataReply, error)
+	ExportStatus(context.Context, *ExportStatusRequest) (*ExportStatusReply, error)
+	UpdatePublicProfile(context.Context, *UpdatePublicProfileRequest) (*UpdatePublicProfileReply, error)
+	Follow(context.Context, *FollowRequest) (*FollowReply, error)
+	Unfollow(context.Context, *UnfollowRequest) (*UnfollowReply, error)
+	ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersReply, error)
+	ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingReply, error)
+}
+
+// UnimplementedUsersServer can be embedded to have forward compatible implementations.
+type UnimplementedUsersServer struct {
+}
+
+func (*UnimplementedUsersServer) Login(context.Context, *LoginRequest) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedUsersServer) Register(context.Context, *RegisterRequest) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedUsersServer) GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicProfile not implemented")
+}
+func (*UnimplementedUsersServer) GetPrivate(context.Context, *GetPrivateRequest) (*GetPrivateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivate not implemented")
+}
+func (*UnimplementedUsersServer) RequestVerification(context.Context, *RequestVerificationRequest) (*RequestVerificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestVerification not implemented")
+}
+func (*UnimplementedUsersServer) ConfirmVerification(context.Context, *ConfirmVerificationRequest) (*ConfirmVerificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmVerification not implemented")
+}
+func (*UnimplementedUsersServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+}
+func (*UnimplementedUsersServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (*UnimplementedUsersServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (*UnimplementedUsersServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (*UnimplementedUsersServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedUsersServer) GrantRole(context.Context, *GrantRoleRequest) (*GrantRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedUsersServer) RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedUsersServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (*UnimplementedUsersServer) ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (*UnimplementedUsersServer) VerifySecondFactor(context.Context, *VerifySecondFactorRequest) (*VerifySecondFactorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySecondFactor not implemented")
+}
+func (*UnimplementedUsersServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (*UnimplementedUsersServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (*UnimplementedUsersServer) OIDCLogin(context.Context, *OIDCLoginRequest) (*OIDCLoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCLogin not implemented")
+}
+func (*UnimplementedUsersServer) OIDCCallback(context.Context, *OIDCCallbackRequest) (*OIDCCallbackReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCCallback not implemented")
+}
+func (*UnimplementedUsersServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (*UnimplementedUsersServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (*UnimplementedUsersServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (*UnimplementedUsersServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (*UnimplementedUsersServer) CancelDeletion(context.Context, *CancelDeletionRequest) (*CancelDeletionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeletion not implemented")
+}
+func (*UnimplementedUsersServer) ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (*UnimplementedUsersServer) ExportStatus(context.Context, *ExportStatusRequest) (*ExportStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportStatus not implemented")
+}
+func (*UnimplementedUsersServer) UpdatePublicProfile(context.Context, *UpdatePublicProfileRequest) (*UpdatePublicProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePublicProfile not implemented")
+}
+func (*UnimplementedUsersServer) Follow(context.Context, *FollowRequest) (*FollowReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (*UnimplementedUsersServer) Unfollow(context.Context, *UnfollowRequest) (*UnfollowReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (*UnimplementedUsersServer) ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (*UnimplementedUsersServer) ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+
+func RegisterUsersServer(s *grpc.Server, srv UsersServer) {
+	s.RegisterService(&_Users_serviceDesc, srv)
+}
+
+func _Users_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_users_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   6,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_users_proto_goTypes,
-		DependencyIndexes: file_users_proto_depIdxs,
-		EnumInfos:         file_users_proto_enumTypes,
-		MessageInfos:      file_users_proto_msgTypes,
-	}.Build()
-	File_users_proto = out.File
-	file_users_proto_rawDesc = nil
-	file_users_proto_goTypes = nil
-	file_users_proto_depIdxs = nil
+	if interceptor == nil {
+		return srv.(UsersServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_GetPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GetPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/GetPublicProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GetPublicProfile(ctx, req.(*GetPublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_GetPrivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GetPrivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/GetPrivate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GetPrivate(ctx, req.(*GetPrivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_RequestVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RequestVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/RequestVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RequestVerification(ctx, req.(*RequestVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ConfirmVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ConfirmVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/ConfirmVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ConfirmVerification(ctx, req.(*ConfirmVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Authenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/Authenticate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Authenticate(ctx, req.(*AuthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GrantRole(ctx, req.(*GrantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RevokeRole(ctx, req.(*RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/EnrollTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_VerifySecondFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySecondFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).VerifySecondFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/VerifySecondFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).VerifySecondFactor(ctx, req.(*VerifySecondFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _Users_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UsersClient is the client API for Users service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UsersClient interface {
-	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error)
-	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error)
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetReply, error)
+func _Users_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type usersClient struct {
-	cc grpc.ClientConnInterface
+func _Users_OIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).OIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/OIDCLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).OIDCLogin(ctx, req.(*OIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewUsersClient(cc grpc.ClientConnInterface) UsersClient {
-	return &usersClient{cc}
+func _Users_OIDCCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).OIDCCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/OIDCCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).OIDCCallback(ctx, req.(*OIDCCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *usersClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error) {
-	out := new(LoginReply)
-	err := c.cc.Invoke(ctx, "/pb.Users/Login", in, out, opts...)
-	if err != nil {
+func _Users_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UsersServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *usersClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error) {
-	out := new(RegisterReply)
-	err := c.cc.Invoke(ctx, "/pb.Users/Register", in, out, opts...)
-	if err != nil {
+func _Users_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UsersServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *usersClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetReply, error) {
-	out := new(GetReply)
-	err := c.cc.Invoke(ctx, "/pb.Users/Get", in, out, opts...)
-	if err != nil {
+func _Users_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UsersServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UsersServer is the server API for Users service.
-type UsersServer interface {
-	Login(context.Context, *LoginRequest) (*LoginReply, error)
-	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
-	Get(context.Context, *GetRequest) (*GetReply, error)
+func _Users_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedUsersServer can be embedded to have forward compatible implementations.
-type UnimplementedUsersServer struct {
+func _Users_CancelDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).CancelDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/CancelDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).CancelDeletion(ctx, req.(*CancelDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedUsersServer) Login(context.Context, *LoginRequest) (*LoginReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+func _Users_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/ExportMyData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ExportMyData(ctx, req.(*ExportMyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUsersServer) Register(context.Context, *RegisterRequest) (*RegisterReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+
+func _Users_ExportStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ExportStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/ExportStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ExportStatus(ctx, req.(*ExportStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUsersServer) Get(context.Context, *GetRequest) (*GetReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func _Users_UpdatePublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).UpdatePublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/UpdatePublicProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).UpdatePublicProfile(ctx, req.(*UpdatePublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUsersServer(s *grpc.Server, srv UsersServer) {
-	s.RegisterService(&_Users_serviceDesc, srv)
+func _Users_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Users/Follow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).Follow(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Users_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Login(ctx, in)
+		return srv.(UsersServer).Unfollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Users/Login",
+		FullMethod: "/pb.Users/Unfollow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Login(ctx, req.(*LoginRequest))
+		return srv.(UsersServer).Unfollow(ctx, req.(*UnfollowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _Users_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Register(ctx, in)
+		return srv.(UsersServer).ListFollowers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Users/Register",
+		FullMethod: "/pb.Users/ListFollowers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(UsersServer).ListFollowers(ctx, req.(*ListFollowersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Users_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
+func _Users_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UsersServer).Get(ctx, in)
+		return srv.(UsersServer).ListFollowing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Users/Get",
+		FullMethod: "/pb.Users/ListFollowing",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServer).Get(ctx, req.(*GetRequest))
+		return srv.(UsersServer).ListFollowing(ctx, req.(*ListFollowingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -863,8 +8291,124 @@ var _Users_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Users_Register_Handler,
 		},
 		{
-			MethodName: "Get",
-			Handler:    _Users_Get_Handler,
+			MethodName: "GetPublicProfile",
+			Handler:    _Users_GetPublicProfile_Handler,
+		},
+		{
+			MethodName: "GetPrivate",
+			Handler:    _Users_GetPrivate_Handler,
+		},
+		{
+			MethodName: "RequestVerification",
+			Handler:    _Users_RequestVerification_Handler,
+		},
+		{
+			MethodName: "ConfirmVerification",
+			Handler:    _Users_ConfirmVerification_Handler,
+		},
+		{
+			MethodName: "Authenticate",
+			Handler:    _Users_Authenticate_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _Users_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _Users_ResetPassword_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _Users_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _Users_ChangePassword_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Users_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Users_RevokeRole_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Users_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _Users_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "VerifySecondFactor",
+			Handler:    _Users_VerifySecondFactor_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Users_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Users_RevokeSession_Handler,
+		},
+		{
+			MethodName: "OIDCLogin",
+			Handler:    _Users_OIDCLogin_Handler,
+		},
+		{
+			MethodName: "OIDCCallback",
+			Handler:    _Users_OIDCCallback_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _Users_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _Users_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _Users_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _Users_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "CancelDeletion",
+			Handler:    _Users_CancelDeletion_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _Users_ExportMyData_Handler,
+		},
+		{
+			MethodName: "ExportStatus",
+			Handler:    _Users_ExportStatus_Handler,
+		},
+		{
+			MethodName: "UpdatePublicProfile",
+			Handler:    _Users_UpdatePublicProfile_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _Users_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _Users_Unfollow_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _Users_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _Users_ListFollowing_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
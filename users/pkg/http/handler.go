@@ -4,29 +4,34 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	http1 "github.com/go-kit/kit/transport/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	endpoint "github.com/emadghaffari/kit-blog/users/pkg/endpoint"
+	"github.com/emadghaffari/kit-blog/users/pkg/lockout"
 )
 
-// makeGetHandler creates the handler logic
-func makeGetHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
-	m.Handle("/get", http1.NewServer(endpoints.GetEndpoint, decodeGetRequest, encodeGetResponse, options...))
+// makeLoginHandler creates the handler logic
+func makeLoginHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/login", http1.NewServer(endpoints.LoginEndpoint, decodeLoginRequest, encodeLoginResponse, options...))
 }
 
-// decodeGetRequest is a transport/http.DecodeRequestFunc that decodes a
+// decodeLoginRequest is a transport/http.DecodeRequestFunc that decodes a
 // JSON-encoded request from the HTTP request body.
-func decodeGetRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	req := endpoint.GetRequest{}
+func decodeLoginRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.LoginRequest{}
 	err := json.NewDecoder(r.Body).Decode(&req)
 	return req, err
 }
 
-// encodeGetResponse is a transport/http.EncodeResponseFunc that encodes
+// encodeLoginResponse is a transport/http.EncodeResponseFunc that encodes
 // the response as JSON to the response writer
-func encodeGetResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+func encodeLoginResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
 	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
 		ErrorEncoder(ctx, f.Failed(), w)
 		return nil
@@ -36,22 +41,22 @@ func encodeGetResponse(ctx context.Context, w http.ResponseWriter, response inte
 	return
 }
 
-// makeLoginHandler creates the handler logic
-func makeLoginHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
-	m.Handle("/login", http1.NewServer(endpoints.LoginEndpoint, decodeLoginRequest, encodeLoginResponse, options...))
+// makeRegisterHandler creates the handler logic
+func makeRegisterHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/register", http1.NewServer(endpoints.RegisterEndpoint, decodeRegisterRequest, encodeRegisterResponse, options...))
 }
 
-// decodeLoginRequest is a transport/http.DecodeRequestFunc that decodes a
+// decodeRegisterRequest is a transport/http.DecodeRequestFunc that decodes a
 // JSON-encoded request from the HTTP request body.
-func decodeLoginRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	req := endpoint.LoginRequest{}
+func decodeRegisterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.RegisterRequest{}
 	err := json.NewDecoder(r.Body).Decode(&req)
 	return req, err
 }
 
-// encodeLoginResponse is a transport/http.EncodeResponseFunc that encodes
+// encodeRegisterResponse is a transport/http.EncodeResponseFunc that encodes
 // the response as JSON to the response writer
-func encodeLoginResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+func encodeRegisterResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
 	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
 		ErrorEncoder(ctx, f.Failed(), w)
 		return nil
@@ -61,22 +66,647 @@ func encodeLoginResponse(ctx context.Context, w http.ResponseWriter, response in
 	return
 }
 
-// makeRegisterHandler creates the handler logic
-func makeRegisterHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
-	m.Handle("/register", http1.NewServer(endpoints.RegisterEndpoint, decodeRegisterRequest, encodeRegisterResponse, options...))
+// makeRequestVerificationHandler creates the handler logic
+func makeRequestVerificationHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/verification/request", http1.NewServer(endpoints.RequestVerificationEndpoint, decodeRequestVerificationRequest, encodeRequestVerificationResponse, options...))
 }
 
-// decodeRegisterRequest is a transport/http.DecodeRequestFunc that decodes a
+// decodeRequestVerificationRequest is a transport/http.DecodeRequestFunc that decodes a
 // JSON-encoded request from the HTTP request body.
-func decodeRegisterRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	req := endpoint.RegisterRequest{}
+func decodeRequestVerificationRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.RequestVerificationRequest{}
 	err := json.NewDecoder(r.Body).Decode(&req)
 	return req, err
 }
 
-// encodeRegisterResponse is a transport/http.EncodeResponseFunc that encodes
+// encodeRequestVerificationResponse is a transport/http.EncodeResponseFunc that encodes
 // the response as JSON to the response writer
-func encodeRegisterResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+func encodeRequestVerificationResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeConfirmVerificationHandler creates the handler logic
+func makeConfirmVerificationHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/verification/confirm", http1.NewServer(endpoints.ConfirmVerificationEndpoint, decodeConfirmVerificationRequest, encodeConfirmVerificationResponse, options...))
+}
+
+// decodeConfirmVerificationRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeConfirmVerificationRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ConfirmVerificationRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeConfirmVerificationResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeConfirmVerificationResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeRequestPasswordResetHandler creates the handler logic
+func makeRequestPasswordResetHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/password/forgot", http1.NewServer(endpoints.RequestPasswordResetEndpoint, decodeRequestPasswordResetRequest, encodeRequestPasswordResetResponse, options...))
+}
+
+// decodeRequestPasswordResetRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeRequestPasswordResetRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.RequestPasswordResetRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeRequestPasswordResetResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeRequestPasswordResetResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeResetPasswordHandler creates the handler logic
+func makeResetPasswordHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/password/reset", http1.NewServer(endpoints.ResetPasswordEndpoint, decodeResetPasswordRequest, encodeResetPasswordResponse, options...))
+}
+
+// decodeResetPasswordRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeResetPasswordRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ResetPasswordRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeResetPasswordResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeResetPasswordResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeUpdateProfileHandler creates the handler logic
+func makeUpdateProfileHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/profile", http1.NewServer(endpoints.UpdateProfileEndpoint, decodeUpdateProfileRequest, encodeUpdateProfileResponse, options...))
+}
+
+// decodeUpdateProfileRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeUpdateProfileRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.UpdateProfileRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeUpdateProfileResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeUpdateProfileResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeChangePasswordHandler creates the handler logic
+func makeChangePasswordHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/password/change", http1.NewServer(endpoints.ChangePasswordEndpoint, decodeChangePasswordRequest, encodeChangePasswordResponse, options...))
+}
+
+// decodeChangePasswordRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeChangePasswordRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ChangePasswordRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeChangePasswordResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeChangePasswordResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeEnrollTOTPHandler creates the handler logic
+func makeEnrollTOTPHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/2fa/enroll", http1.NewServer(endpoints.EnrollTOTPEndpoint, decodeEnrollTOTPRequest, encodeEnrollTOTPResponse, options...))
+}
+
+// decodeEnrollTOTPRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeEnrollTOTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.EnrollTOTPRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeEnrollTOTPResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeEnrollTOTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeConfirmTOTPHandler creates the handler logic
+func makeConfirmTOTPHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/2fa/confirm", http1.NewServer(endpoints.ConfirmTOTPEndpoint, decodeConfirmTOTPRequest, encodeConfirmTOTPResponse, options...))
+}
+
+// decodeConfirmTOTPRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeConfirmTOTPRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ConfirmTOTPRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeConfirmTOTPResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeConfirmTOTPResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeVerifySecondFactorHandler creates the handler logic
+func makeVerifySecondFactorHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/2fa/verify", http1.NewServer(endpoints.VerifySecondFactorEndpoint, decodeVerifySecondFactorRequest, encodeVerifySecondFactorResponse, options...))
+}
+
+// decodeVerifySecondFactorRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeVerifySecondFactorRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.VerifySecondFactorRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeVerifySecondFactorResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeVerifySecondFactorResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeListSessionsHandler creates the handler logic
+func makeListSessionsHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/sessions", http1.NewServer(endpoints.ListSessionsEndpoint, decodeListSessionsRequest, encodeListSessionsResponse, options...))
+}
+
+// decodeListSessionsRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeListSessionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ListSessionsRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeListSessionsResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeListSessionsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeRevokeSessionHandler creates the handler logic
+func makeRevokeSessionHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/sessions/revoke", http1.NewServer(endpoints.RevokeSessionEndpoint, decodeRevokeSessionRequest, encodeRevokeSessionResponse, options...))
+}
+
+// decodeRevokeSessionRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeRevokeSessionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.RevokeSessionRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeRevokeSessionResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeRevokeSessionResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeOIDCLoginHandler creates the handler logic
+func makeOIDCLoginHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/oidc/login", http1.NewServer(endpoints.OIDCLoginEndpoint, decodeOIDCLoginRequest, encodeOIDCLoginResponse, options...))
+}
+
+// decodeOIDCLoginRequest is a transport/http.DecodeRequestFunc for a request
+// without parameters.
+func decodeOIDCLoginRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return endpoint.OIDCLoginRequest{}, nil
+}
+
+// encodeOIDCLoginResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeOIDCLoginResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeOIDCCallbackHandler creates the handler logic
+func makeOIDCCallbackHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/oidc/callback", http1.NewServer(endpoints.OIDCCallbackEndpoint, decodeOIDCCallbackRequest, encodeOIDCCallbackResponse, options...))
+}
+
+// decodeOIDCCallbackRequest is a transport/http.DecodeRequestFunc that decodes
+// the query the provider redirects the browser back with.
+func decodeOIDCCallbackRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		return nil, fmt.Errorf("oidc provider: %s %s", e, q.Get("error_description"))
+	}
+	return endpoint.OIDCCallbackRequest{State: q.Get("state"), Code: q.Get("code")}, nil
+}
+
+// encodeOIDCCallbackResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeOIDCCallbackResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeCreateAPIKeyHandler creates the handler logic
+func makeCreateAPIKeyHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/keys/create", http1.NewServer(endpoints.CreateAPIKeyEndpoint, decodeCreateAPIKeyRequest, encodeCreateAPIKeyResponse, options...))
+}
+
+// decodeCreateAPIKeyRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeCreateAPIKeyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.CreateAPIKeyRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeCreateAPIKeyResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeCreateAPIKeyResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeListAPIKeysHandler creates the handler logic
+func makeListAPIKeysHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/keys", http1.NewServer(endpoints.ListAPIKeysEndpoint, decodeListAPIKeysRequest, encodeListAPIKeysResponse, options...))
+}
+
+// decodeListAPIKeysRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeListAPIKeysRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ListAPIKeysRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeListAPIKeysResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeListAPIKeysResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeRevokeAPIKeyHandler creates the handler logic
+func makeRevokeAPIKeyHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/keys/revoke", http1.NewServer(endpoints.RevokeAPIKeyEndpoint, decodeRevokeAPIKeyRequest, encodeRevokeAPIKeyResponse, options...))
+}
+
+// decodeRevokeAPIKeyRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeRevokeAPIKeyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.RevokeAPIKeyRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeRevokeAPIKeyResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeRevokeAPIKeyResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeDeleteAccountHandler creates the handler logic
+func makeDeleteAccountHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/account/delete", http1.NewServer(endpoints.DeleteAccountEndpoint, decodeDeleteAccountRequest, encodeDeleteAccountResponse, options...))
+}
+
+// decodeDeleteAccountRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeDeleteAccountRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.DeleteAccountRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeDeleteAccountResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeDeleteAccountResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeExportMyDataHandler creates the handler logic
+func makeExportMyDataHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/export", http1.NewServer(endpoints.ExportMyDataEndpoint, decodeExportMyDataRequest, encodeExportMyDataResponse, options...))
+}
+
+// decodeExportMyDataRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeExportMyDataRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ExportMyDataRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeExportMyDataResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeExportMyDataResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeExportStatusHandler creates the handler logic
+func makeExportStatusHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/export/status", http1.NewServer(endpoints.ExportStatusEndpoint, decodeExportStatusRequest, encodeExportStatusResponse, options...))
+}
+
+// decodeExportStatusRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeExportStatusRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ExportStatusRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeExportStatusResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeExportStatusResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeGetPublicProfileHandler creates the handler logic
+func makeGetPublicProfileHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/profile", http1.NewServer(endpoints.GetPublicProfileEndpoint, decodeGetPublicProfileRequest, encodeGetPublicProfileResponse, options...))
+}
+
+// decodeGetPublicProfileRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeGetPublicProfileRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.GetPublicProfileRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeGetPublicProfileResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeGetPublicProfileResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeUpdatePublicProfileHandler creates the handler logic
+func makeUpdatePublicProfileHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/profile/update", http1.NewServer(endpoints.UpdatePublicProfileEndpoint, decodeUpdatePublicProfileRequest, encodeUpdatePublicProfileResponse, options...))
+}
+
+// decodeUpdatePublicProfileRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeUpdatePublicProfileRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.UpdatePublicProfileRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeUpdatePublicProfileResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeUpdatePublicProfileResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeFollowHandler creates the handler logic
+func makeFollowHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/follow", http1.NewServer(endpoints.FollowEndpoint, decodeFollowRequest, encodeFollowResponse, options...))
+}
+
+// decodeFollowRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeFollowRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.FollowRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeFollowResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeFollowResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeUnfollowHandler creates the handler logic
+func makeUnfollowHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/unfollow", http1.NewServer(endpoints.UnfollowEndpoint, decodeUnfollowRequest, encodeUnfollowResponse, options...))
+}
+
+// decodeUnfollowRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeUnfollowRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.UnfollowRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeUnfollowResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeUnfollowResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeListFollowersHandler creates the handler logic
+func makeListFollowersHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/followers", http1.NewServer(endpoints.ListFollowersEndpoint, decodeListFollowersRequest, encodeListFollowersResponse, options...))
+}
+
+// decodeListFollowersRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeListFollowersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ListFollowersRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeListFollowersResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeListFollowersResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeListFollowingHandler creates the handler logic
+func makeListFollowingHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/following", http1.NewServer(endpoints.ListFollowingEndpoint, decodeListFollowingRequest, encodeListFollowingResponse, options...))
+}
+
+// decodeListFollowingRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeListFollowingRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.ListFollowingRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeListFollowingResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeListFollowingResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
+		ErrorEncoder(ctx, f.Failed(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(response)
+	return
+}
+
+// makeCancelDeletionHandler creates the handler logic
+func makeCancelDeletionHandler(m *http.ServeMux, endpoints endpoint.Endpoints, options []http1.ServerOption) {
+	m.Handle("/account/cancel-deletion", http1.NewServer(endpoints.CancelDeletionEndpoint, decodeCancelDeletionRequest, encodeCancelDeletionResponse, options...))
+}
+
+// decodeCancelDeletionRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded request from the HTTP request body.
+func decodeCancelDeletionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := endpoint.CancelDeletionRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	return req, err
+}
+
+// encodeCancelDeletionResponse is a transport/http.EncodeResponseFunc that encodes
+// the response as JSON to the response writer
+func encodeCancelDeletionResponse(ctx context.Context, w http.ResponseWriter, response interface{}) (err error) {
 	if f, ok := response.(endpoint.Failure); ok && f.Failed() != nil {
 		ErrorEncoder(ctx, f.Failed(), w)
 		return nil
@@ -86,6 +716,9 @@ func encodeRegisterResponse(ctx context.Context, w http.ResponseWriter, response
 	return
 }
 func ErrorEncoder(_ context.Context, err error, w http.ResponseWriter) {
+	if locked, ok := err.(*lockout.LockedError); ok {
+		w.Header().Set("Retry-After", strconv.FormatInt(locked.Seconds(), 10))
+	}
 	w.WriteHeader(err2code(err))
 	json.NewEncoder(w).Encode(errorWrapper{Error: err.Error()})
 }
@@ -100,6 +733,14 @@ func ErrorDecoder(r *http.Response) error {
 // This is used to set the http status, see an example here :
 // https://github.com/go-kit/kit/blob/master/examples/addsvc/pkg/addtransport/http.go#L133
 func err2code(err error) int {
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	}
 	return http.StatusInternalServerError
 }
 
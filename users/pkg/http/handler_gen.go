@@ -11,8 +11,33 @@ import (
 // predefined paths.
 func NewHTTPHandler(endpoints endpoint.Endpoints, options map[string][]http.ServerOption) http1.Handler {
 	m := http1.NewServeMux()
-	makeGetHandler(m, endpoints, options["Get"])
 	makeLoginHandler(m, endpoints, options["Login"])
 	makeRegisterHandler(m, endpoints, options["Register"])
+	makeRequestVerificationHandler(m, endpoints, options["RequestVerification"])
+	makeConfirmVerificationHandler(m, endpoints, options["ConfirmVerification"])
+	makeRequestPasswordResetHandler(m, endpoints, options["RequestPasswordReset"])
+	makeResetPasswordHandler(m, endpoints, options["ResetPassword"])
+	makeUpdateProfileHandler(m, endpoints, options["UpdateProfile"])
+	makeChangePasswordHandler(m, endpoints, options["ChangePassword"])
+	makeEnrollTOTPHandler(m, endpoints, options["EnrollTOTP"])
+	makeConfirmTOTPHandler(m, endpoints, options["ConfirmTOTP"])
+	makeVerifySecondFactorHandler(m, endpoints, options["VerifySecondFactor"])
+	makeListSessionsHandler(m, endpoints, options["ListSessions"])
+	makeRevokeSessionHandler(m, endpoints, options["RevokeSession"])
+	makeOIDCLoginHandler(m, endpoints, options["OIDCLogin"])
+	makeOIDCCallbackHandler(m, endpoints, options["OIDCCallback"])
+	makeCreateAPIKeyHandler(m, endpoints, options["CreateAPIKey"])
+	makeListAPIKeysHandler(m, endpoints, options["ListAPIKeys"])
+	makeRevokeAPIKeyHandler(m, endpoints, options["RevokeAPIKey"])
+	makeDeleteAccountHandler(m, endpoints, options["DeleteAccount"])
+	makeExportMyDataHandler(m, endpoints, options["ExportMyData"])
+	makeExportStatusHandler(m, endpoints, options["ExportStatus"])
+	makeGetPublicProfileHandler(m, endpoints, options["GetPublicProfile"])
+	makeUpdatePublicProfileHandler(m, endpoints, options["UpdatePublicProfile"])
+	makeFollowHandler(m, endpoints, options["Follow"])
+	makeUnfollowHandler(m, endpoints, options["Unfollow"])
+	makeListFollowersHandler(m, endpoints, options["ListFollowers"])
+	makeListFollowingHandler(m, endpoints, options["ListFollowing"])
+	makeCancelDeletionHandler(m, endpoints, options["CancelDeletion"])
 	return m
 }
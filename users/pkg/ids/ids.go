@@ -0,0 +1,46 @@
+package ids
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sync"
+)
+
+// Generator returns unique, unguessable identifiers.
+type Generator interface {
+	New() (string, error)
+}
+
+// Random reads Bytes bytes from crypto/rand and encodes them URL safe, so
+// ids can be used in redis keys and URLs as they are. It is safe for
+// concurrent use.
+type Random struct {
+	Bytes int
+}
+
+// New implements Generator.
+func (r Random) New() (string, error) {
+	b := make([]byte, r.Bytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Sequence returns Prefix followed by a counter, for deterministic ids in
+// tests.
+type Sequence struct {
+	Prefix string
+
+	mu sync.Mutex
+	n  int
+}
+
+// New implements Generator.
+func (s *Sequence) New() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.n++
+	return fmt.Sprintf("%s%d", s.Prefix, s.n), nil
+}
@@ -0,0 +1,82 @@
+package ids
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestRandomUniqueConcurrent(t *testing.T) {
+	const workers, each = 32, 1000
+	r := Random{Bytes: 32}
+
+	var mu sync.Mutex
+	seen := make(map[string]bool, workers*each)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < each; i++ {
+				id, err := r.New()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				mu.Lock()
+				if seen[id] {
+					t.Errorf("duplicate id %q", id)
+				}
+				seen[id] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != workers*each {
+		t.Fatalf("got %d ids, want %d", len(seen), workers*each)
+	}
+}
+
+func TestSequenceDeterministic(t *testing.T) {
+	a, b := &Sequence{Prefix: "id-"}, &Sequence{Prefix: "id-"}
+	for i := 1; i <= 3; i++ {
+		x, _ := a.New()
+		y, _ := b.New()
+		if want := fmt.Sprintf("id-%d", i); x != want || y != want {
+			t.Fatalf("got %q and %q, want %q", x, y, want)
+		}
+	}
+}
+
+func TestSequenceUniqueConcurrent(t *testing.T) {
+	const workers, each = 16, 100
+	s := &Sequence{}
+
+	ids := make(chan string, workers*each)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < each; i++ {
+				id, _ := s.New()
+				ids <- id
+			}
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := map[string]bool{}
+	for id := range ids {
+		if seen[id] {
+			t.Fatalf("duplicate id %q", id)
+		}
+		seen[id] = true
+	}
+	if len(seen) != workers*each {
+		t.Fatalf("got %d ids, want %d", len(seen), workers*each)
+	}
+}
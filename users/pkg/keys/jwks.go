@@ -0,0 +1,136 @@
+package keys
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// JWK is the public part of an RSA signing key, RFC 7517.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKS is the document served on /.well-known/jwks.json.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// FromPublic returns the JWK of key.
+func FromPublic(kid string, key *rsa.PublicKey) JWK {
+	return JWK{
+		Kty: "RSA",
+		Use: "sig",
+		Alg: "RS256",
+		Kid: kid,
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+// PublicKey decodes the RSA key of k.
+func (k JWK) PublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" {
+		return nil, fmt.Errorf("key %s: unsupported key type %q", k.Kid, k.Kty)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+}
+
+// Handler serves the JWKS of set. Verifiers may cache it for a few minutes.
+func Handler(set *Set) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		json.NewEncoder(w).Encode(set.JWKS())
+	})
+}
+
+// Remote fetches the JWKS of the users service, so other services verify
+// tokens without its secrets. An unknown kid refetches the document, at
+// most once per MinRefresh, to pick up rotated keys.
+type Remote struct {
+	URL        string
+	MinRefresh time.Duration
+	Client     *http.Client
+
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	fetched time.Time
+}
+
+// NewRemote returns a Remote reading the JWKS at url.
+func NewRemote(url string) *Remote {
+	return &Remote{
+		URL:        url,
+		MinRefresh: time.Minute,
+		Client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Public implements PublicKeys.
+func (r *Remote) Public(kid string) (*rsa.PublicKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if key, ok := r.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(r.fetched) < r.MinRefresh {
+		return nil, ErrUnknownKey
+	}
+	if err := r.fetch(); err != nil {
+		return nil, err
+	}
+	if key, ok := r.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+func (r *Remote) fetch() error {
+	r.fetched = time.Now()
+	res, err := r.Client.Get(r.URL)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("jwks: unexpected status %s", res.Status)
+	}
+
+	set := JWKS{}
+	if err := json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return err
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		// providers publish other kinds of keys next to their RSA ones
+		if k.Kty != "RSA" {
+			continue
+		}
+		key, err := k.PublicKey()
+		if err != nil {
+			return err
+		}
+		keys[k.Kid] = key
+	}
+	r.keys = keys
+	return nil
+}
@@ -0,0 +1,138 @@
+package keys
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	jjwt "github.com/dgrijalva/jwt-go"
+)
+
+var (
+	// ErrNoSigningKey is returned before a key has been activated.
+	ErrNoSigningKey = errors.New("no active signing key")
+	// ErrUnknownKey is returned for a kid that is not in the set.
+	ErrUnknownKey = errors.New("unknown signing key")
+)
+
+// PublicKeys looks up the key a token was signed with.
+type PublicKeys interface {
+	Public(kid string) (*rsa.PublicKey, error)
+}
+
+// Set holds the RS256 keys of the users service. Tokens are signed with the
+// active key; the others still verify, so a key can be rotated out once the
+// tokens it signed have expired.
+type Set struct {
+	mu     sync.RWMutex
+	active string
+	keys   map[string]*rsa.PrivateKey
+}
+
+// New returns an empty Set.
+func New() *Set {
+	return &Set{keys: map[string]*rsa.PrivateKey{}}
+}
+
+// Generate returns a new 2048 bit key and its kid.
+func Generate() (string, *rsa.PrivateKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", nil, err
+	}
+	return Thumbprint(&key.PublicKey), key, nil
+}
+
+// Thumbprint returns the RFC 7638 thumbprint of key, used as its kid.
+func Thumbprint(key *rsa.PublicKey) string {
+	jwk := FromPublic("", key)
+	sum := sha256.Sum256([]byte(fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, jwk.E, jwk.N)))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// EncodePEM returns key as a PKCS#1 PEM block, the format Vault stores.
+func EncodePEM(key *rsa.PrivateKey) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+// Replace swaps the keys of the set for keys, signing with active.
+func (s *Set) Replace(keys map[string]*rsa.PrivateKey, active string) error {
+	if _, ok := keys[active]; !ok {
+		return ErrNoSigningKey
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	s.active = active
+	return nil
+}
+
+// ParsePEM decodes the private keys in pems, by kid.
+func ParsePEM(pems map[string]string) (map[string]*rsa.PrivateKey, error) {
+	keys := make(map[string]*rsa.PrivateKey, len(pems))
+	for kid, p := range pems {
+		key, err := jjwt.ParseRSAPrivateKeyFromPEM([]byte(p))
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %v", kid, err)
+		}
+		keys[kid] = key
+	}
+	return keys, nil
+}
+
+// Signing returns the active key and its kid.
+func (s *Set) Signing() (string, *rsa.PrivateKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.keys[s.active]
+	if !ok {
+		return "", nil, ErrNoSigningKey
+	}
+	return s.active, key, nil
+}
+
+// Public implements PublicKeys.
+func (s *Set) Public(kid string) (*rsa.PublicKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return &key.PublicKey, nil
+}
+
+// JWKS returns the public half of every key in the set.
+func (s *Set) JWKS() JWKS {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	kids := make([]string, 0, len(s.keys))
+	for kid := range s.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	set := JWKS{Keys: make([]JWK, 0, len(kids))}
+	for _, kid := range kids {
+		set.Keys = append(set.Keys, FromPublic(kid, &s.keys[kid].PublicKey))
+	}
+	return set
+}
+
+// Keyfunc returns a jwt-go Keyfunc accepting RS256 tokens signed by keys.
+func Keyfunc(keys PublicKeys) jjwt.Keyfunc {
+	return func(t *jjwt.Token) (interface{}, error) {
+		if t.Method != jjwt.SigningMethodRS256 {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		kid, _ := t.Header["kid"].(string)
+		return keys.Public(kid)
+	}
+}
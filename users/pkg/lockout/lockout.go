@@ -0,0 +1,153 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	rd "github.com/go-redis/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/redis"
+)
+
+// LockedError is returned while a username or a client is not allowed to
+// try another password.
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry after %d seconds", e.Seconds())
+}
+
+// Seconds returns RetryAfter rounded up to whole seconds, as used by the
+// Retry-After header.
+func (e *LockedError) Seconds() int64 {
+	return int64((e.RetryAfter + time.Second - 1) / time.Second)
+}
+
+// GRPCStatus lets grpc report the error as ResourceExhausted.
+func (e *LockedError) GRPCStatus() *status.Status {
+	return status.New(codes.ResourceExhausted, e.Error())
+}
+
+// Guard counts failed logins per username and per client IP in sliding
+// windows kept in redis sorted sets.
+type Guard struct {
+	// Window is how far back failures are counted.
+	Window time.Duration
+	// MaxFailures locks a username for LockFor once reached in Window.
+	MaxFailures int64
+	LockFor     time.Duration
+	// MaxIPFailures rejects a client IP until its failures leave Window,
+	// it is higher as one client may try several usernames.
+	MaxIPFailures int64
+	// Delay is how long a failed login is held back, it doubles on every
+	// following failure up to MaxDelay.
+	Delay    time.Duration
+	MaxDelay time.Duration
+}
+
+// New returns a Guard locking a username for lockFor after maxFailures
+// failures in window.
+func New(window time.Duration, maxFailures int64, lockFor time.Duration) *Guard {
+	return &Guard{
+		Window:        window,
+		MaxFailures:   maxFailures,
+		LockFor:       lockFor,
+		MaxIPFailures: maxFailures * 4,
+		Delay:         250 * time.Millisecond,
+		MaxDelay:      4 * time.Second,
+	}
+}
+
+// Check returns a LockedError when username is locked or ip has failed too
+// often, without counting anything.
+func (g *Guard) Check(ctx context.Context, username, ip string) error {
+	db := redis.DB.GetDB()
+
+	ttl, err := db.PTTL(ctx, lockKey(username)).Result()
+	if err != nil {
+		return err
+	}
+	if ttl > 0 {
+		return &LockedError{RetryAfter: ttl}
+	}
+
+	if ip == "" {
+		return nil
+	}
+	n, err := g.count(ctx, ipKey(ip), time.Now())
+	if err != nil {
+		return err
+	}
+	if n >= g.MaxIPFailures {
+		return &LockedError{RetryAfter: g.Window}
+	}
+	return nil
+}
+
+// Fail records a failed login. It returns how long the caller should hold
+// the answer back, or a LockedError when this failure locked the username.
+func (g *Guard) Fail(ctx context.Context, username, ip string) (time.Duration, error) {
+	now := time.Now()
+	n, err := g.add(ctx, userKey(username), now)
+	if err != nil {
+		return 0, err
+	}
+	if ip != "" {
+		if _, err := g.add(ctx, ipKey(ip), now); err != nil {
+			return 0, err
+		}
+	}
+
+	if n >= g.MaxFailures {
+		pipe := redis.DB.GetDB().TxPipeline()
+		pipe.Set(ctx, lockKey(username), now.Unix(), g.LockFor)
+		pipe.Del(ctx, userKey(username))
+		if _, err := pipe.Exec(ctx); err != nil {
+			return 0, err
+		}
+		return 0, &LockedError{RetryAfter: g.LockFor}
+	}
+
+	d := g.Delay
+	for i := int64(1); i < n && d < g.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > g.MaxDelay {
+		d = g.MaxDelay
+	}
+	return d, nil
+}
+
+// Reset forgets the failures of username after a successful login.
+func (g *Guard) Reset(ctx context.Context, username string) error {
+	return redis.DB.GetDB().Del(ctx, userKey(username)).Err()
+}
+
+// add records a failure at now and returns the failures in the window.
+func (g *Guard) add(ctx context.Context, key string, now time.Time) (int64, error) {
+	pipe := redis.DB.GetDB().TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-g.Window).UnixNano(), 10))
+	pipe.ZAdd(ctx, key, &rd.Z{Score: float64(now.UnixNano()), Member: now.UnixNano()})
+	card := pipe.ZCard(ctx, key)
+	pipe.Expire(ctx, key, g.Window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return card.Val(), nil
+}
+
+// count returns the failures in the window without recording one.
+func (g *Guard) count(ctx context.Context, key string, now time.Time) (int64, error) {
+	min := strconv.FormatInt(now.Add(-g.Window).UnixNano(), 10)
+	return redis.DB.GetDB().ZCount(ctx, key, min, "+inf").Result()
+}
+
+func userKey(username string) string { return "login:fail:user:" + username }
+func ipKey(ip string) string         { return "login:fail:ip:" + ip }
+func lockKey(username string) string { return "login:lock:" + username }
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/auth"
+)
+
+// APIKey is a personal key of a user for machine clients. The key itself is
+// shown once when it is created, only its hash is stored.
+type APIKey struct {
+	ID   string `json:"id" bson:"id"`
+	Name string `json:"name" bson:"name"`
+	// Prefix is the start of the key, for the user to recognise it by
+	Prefix     string    `json:"prefix" bson:"prefix"`
+	Hash       string    `json:"-" bson:"hash"`
+	Scopes     []string  `json:"scopes" bson:"scopes"`
+	CreatedAt  time.Time `json:"created_at" bson:"createdAt"`
+	ExpiresAt  time.Time `json:"expires_at" bson:"expiresAt"`
+	LastUsedAt time.Time `json:"last_used_at" bson:"lastUsedAt,omitempty"`
+}
+
+// NewAPIKey returns a new key and its record.
+func NewAPIKey(name string, scopes []string, expires time.Time) (APIKey, string, error) {
+	id, err := IDs.New()
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	secret, err := IDs.New()
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	key := auth.APIKeyPrefix + secret
+	return APIKey{
+		ID:        id,
+		Name:      name,
+		Prefix:    key[:len(auth.APIKeyPrefix)+6],
+		Hash:      HashAPIKey(key),
+		Scopes:    scopes,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expires.UTC(),
+	}, key, nil
+}
+
+// HashAPIKey returns the stored form of key. Keys are random, so a fast
+// hash is enough.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
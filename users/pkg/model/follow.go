@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Follow is a user following an author, whose posts then show up in the
+// feed of the user.
+type Follow struct {
+	ID        string    `json:"id" bson:"_id,omitempty"`
+	Follower  string    `json:"follower" bson:"follower"`
+	Followee  string    `json:"followee" bson:"followee"`
+	CreatedAt time.Time `json:"created_at" bson:"createdAt"`
+}
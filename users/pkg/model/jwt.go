@@ -3,14 +3,17 @@ package model
 import (
 	"context"
 	"encoding/json"
-	"math/rand"
+	"errors"
 	"time"
 
 	jjwt "github.com/dgrijalva/jwt-go"
 	"github.com/spf13/viper"
 
 	"github.com/emadghaffari/kit-blog/users/config"
+	"github.com/emadghaffari/kit-blog/users/pkg/ids"
+	"github.com/emadghaffari/kit-blog/users/pkg/keys"
 	"github.com/emadghaffari/kit-blog/users/pkg/redis"
+	"github.com/emadghaffari/kit-blog/users/pkg/tokens"
 )
 
 // jwt struct
@@ -21,21 +24,37 @@ type jwt struct {
 	RefreshUUID  string `json:"rau"`
 	AtExpires    int64  `json:"exp"`
 	RtExpires    int64  `json:"rexp"`
+	SessionID    string `json:"sid"`
 }
 
 var (
 	// Conf variable instance of intef
 	Conf intef = &wt{}
+
+	// Keys signs the access tokens, its public half is served as a JWKS
+	Keys = keys.New()
+
+	// IDs names the sessions and API keys, tests may swap it for an ids.Sequence
+	IDs ids.Generator = ids.Random{Bytes: 32}
+
+	// ErrSession is returned for a well signed token whose session is gone,
+	// because it expired or the user logged out.
+	ErrSession = errors.New("session not found")
 )
 
 type intef interface {
-	Generate(data User) (*jwt, error)
+	Generate(ctx context.Context, data User) (*jwt, error)
+	Verify(token string) (User, error)
+	RevokeAll(userID string) error
+	Sessions(userID string) ([]Session, error)
+	Revoke(userID, sid string) error
 }
 type wt struct{}
 
-func (j *wt) Generate(user User) (*jwt, error) {
+// Generate starts a session for user on the client of ctx.
+func (j *wt) Generate(ctx context.Context, user User) (*jwt, error) {
 
-	td, err := j.genJWT()
+	td, err := j.genJWT(user)
 	if err != nil {
 		return nil, err
 	}
@@ -44,36 +63,88 @@ func (j *wt) Generate(user User) (*jwt, error) {
 		return nil, err
 	}
 
-	if err := j.redis(user, td); err != nil {
+	if err := j.redis(ctx, user, td); err != nil {
 		return nil, err
 	}
 
 	return td, nil
 }
 
-func (j *wt) genJWT() (*jwt, error) {
+// Verify checks the signature and expiry of an access token and returns
+// the user of its session.
+func (j *wt) Verify(token string) (User, error) {
+	claims, err := Parser().Parse(token)
+	if err != nil {
+		return User{}, err
+	}
+
+	user := User{}
+	if claims.UUID == "" {
+		return user, ErrSession
+	}
+	if err := redis.DB.Get(claims.UUID, &user); err != nil {
+		return user, ErrSession
+	}
+	touch(claims.Session)
+	return user, nil
+}
+
+func (j *wt) genJWT(user User) (*jwt, error) {
 	// create new jwt
+	now := time.Now()
 	td := &jwt{}
-	td.AtExpires = time.Now().Add(time.Duration(time.Minute * viper.GetDuration("jwt.expire"))).Unix()
+	td.AtExpires = now.Add(time.Duration(time.Minute * viper.GetDuration("jwt.expire"))).Unix()
 	td.RtExpires = time.Now().Add(time.Duration(time.Minute * viper.GetDuration("jwt.RTexpire"))).Unix()
-	td.AccessUUID = hasher(30)
-	td.RefreshUUID = hasher(60)
+	var err error
+	if td.AccessUUID, err = IDs.New(); err != nil {
+		return nil, err
+	}
+	if td.RefreshUUID, err = IDs.New(); err != nil {
+		return nil, err
+	}
+	if td.SessionID, err = IDs.New(); err != nil {
+		return nil, err
+	}
 
-	// New MapClaims for access token
-	atClaims := jjwt.MapClaims{}
-	atClaims["authorized"] = true
-	atClaims["uuid"] = td.AccessUUID
-	atClaims["exp"] = td.AtExpires
-	at := jjwt.NewWithClaims(jjwt.SigningMethodHS256, atClaims)
+	// consumers learn who the user is from the claims, without redis
+	atClaims := &tokens.Claims{
+		Subject:    user.ID,
+		Issuer:     config.Confs.JWT.Issuer,
+		Audience:   tokens.Audience{config.Confs.JWT.Audience},
+		ID:         td.AccessUUID,
+		IssuedAt:   now.Unix(),
+		NotBefore:  now.Unix(),
+		ExpiresAt:  td.AtExpires,
+		Username:   user.Username,
+		Roles:      user.Roles,
+		UUID:       td.AccessUUID,
+		Session:    td.SessionID,
+		Authorized: true,
+	}
+	kid, key, err := Keys.Signing()
+	if err != nil {
+		return nil, err
+	}
+	at := jjwt.NewWithClaims(jjwt.SigningMethodRS256, atClaims)
+	at.Header["kid"] = kid
 
-	var err error
-	td.AccessToken, err = at.SignedString([]byte(config.Confs.JWT.Secret))
+	td.AccessToken, err = at.SignedString(key)
 	if err != nil {
 		return nil, err
 	}
 	return td, nil
 }
 
+// Parser returns the parser for the access tokens of this service.
+func Parser() *tokens.Parser {
+	return &tokens.Parser{
+		Keys:     Keys,
+		Issuer:   config.Confs.JWT.Issuer,
+		Audience: config.Confs.JWT.Audience,
+		Skew:     config.Confs.JWT.Skew,
+	}
+}
+
 func (j *wt) genRefJWT(td *jwt) error {
 	// New MapClaims for refresh access token
 	rtClaims := jjwt.MapClaims{}
@@ -89,17 +160,18 @@ func (j *wt) genRefJWT(td *jwt) error {
 	return nil
 }
 
-func (j *wt) redis(user User, td *jwt) error {
+func (j *wt) redis(ctx context.Context, user User, td *jwt) error {
 	at := time.Unix(td.AtExpires, 0) //converting Unix to UTC(to Time object)
 	rt := time.Unix(td.RtExpires, 0)
 	now := time.Now()
 
 	// make map for store in redis
-	us := make(map[string]interface{}, 4)
+	us := make(map[string]interface{}, 5)
 	us["id"] = user.ID
 	us["username"] = user.Username
 	us["email"] = user.Email
 	us["phone"] = user.Phone
+	us["roles"] = user.Roles
 
 	bt, err := json.Marshal(us)
 	if err != nil {
@@ -112,16 +184,33 @@ func (j *wt) redis(user User, td *jwt) error {
 	if err := redis.DB.GetDB().Set(context.Background(), td.RefreshUUID, string(bt), rt.Sub(now)).Err(); err != nil {
 		return err
 	}
+
+	meta, err := saveSession(ctx, user.ID, td, rt)
+	if err != nil {
+		return err
+	}
+
+	// index the sessions of the user so they can be revoked together
+	key := sessionsKey(user.ID)
+	if err := redis.DB.GetDB().SAdd(context.Background(), key, td.AccessUUID, td.RefreshUUID, meta).Err(); err != nil {
+		return err
+	}
+	if err := redis.DB.GetDB().ExpireAt(context.Background(), key, rt).Err(); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Generate hash key
-func hasher(lenght int) string {
-	letters := []int32("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ123456789-&()_")
-	rand.Seed(time.Now().UnixNano())
-	b := make([]int32, lenght)
-	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
+// RevokeAll ends every session of the user.
+func (j *wt) RevokeAll(userID string) error {
+	key := sessionsKey(userID)
+	uuids, err := redis.DB.GetDB().SMembers(context.Background(), key).Result()
+	if err != nil {
+		return err
 	}
-	return string(b)
+	return redis.DB.Del(append(uuids, key)...)
+}
+
+func sessionsKey(userID string) string {
+	return "sessions:" + userID
 }
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/device"
+	"github.com/emadghaffari/kit-blog/users/pkg/redis"
+)
+
+// Session is a login of a user, as shown in their list of devices.
+type Session struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// saveSession stores the device of a new session next to its tokens, the
+// key is indexed with them so RevokeAll drops it too.
+func saveSession(ctx context.Context, userID string, td *jwt, expires time.Time) (string, error) {
+	client := device.FromContext(ctx)
+	now := time.Now().Unix()
+	key := sessionKey(td.SessionID)
+
+	pipe := redis.DB.GetDB().TxPipeline()
+	pipe.HSet(context.Background(), key,
+		"userID", userID,
+		"access", td.AccessUUID,
+		"refresh", td.RefreshUUID,
+		"userAgent", client.UserAgent,
+		"ip", client.IP,
+		"createdAt", now,
+		"lastUsedAt", now,
+		"expiresAt", expires.Unix(),
+	)
+	pipe.ExpireAt(context.Background(), key, expires)
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// touch records that a session was used just now.
+func touch(sid string) {
+	if sid == "" {
+		return
+	}
+	key := sessionKey(sid)
+	redis.DB.GetDB().HSet(context.Background(), key, "lastUsedAt", time.Now().Unix())
+}
+
+// Sessions returns the live sessions of the user, most recently used first.
+func (j *wt) Sessions(userID string) ([]Session, error) {
+	db := redis.DB.GetDB()
+	members, err := db.SMembers(context.Background(), sessionsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := []Session{}
+	for _, m := range members {
+		if !strings.HasPrefix(m, sessionPrefix) {
+			continue
+		}
+		vals, err := db.HGetAll(context.Background(), m).Result()
+		if err != nil {
+			return nil, err
+		}
+		// expired, forget it
+		if len(vals) == 0 {
+			db.SRem(context.Background(), sessionsKey(userID), m)
+			continue
+		}
+		sessions = append(sessions, Session{
+			ID:         strings.TrimPrefix(m, sessionPrefix),
+			UserAgent:  vals["userAgent"],
+			IP:         vals["ip"],
+			CreatedAt:  unix(vals["createdAt"]),
+			LastUsedAt: unix(vals["lastUsedAt"]),
+			ExpiresAt:  unix(vals["expiresAt"]),
+		})
+	}
+
+	sort.Slice(sessions, func(a, b int) bool {
+		return sessions[a].LastUsedAt.After(sessions[b].LastUsedAt)
+	})
+	return sessions, nil
+}
+
+// Revoke ends one session of the user.
+func (j *wt) Revoke(userID, sid string) error {
+	db := redis.DB.GetDB()
+	key := sessionKey(sid)
+	vals, err := db.HGetAll(context.Background(), key).Result()
+	if err != nil {
+		return err
+	}
+	if len(vals) == 0 || vals["userID"] != userID {
+		return ErrSession
+	}
+
+	pipe := db.TxPipeline()
+	pipe.Del(context.Background(), vals["access"], vals["refresh"], key)
+	pipe.SRem(context.Background(), sessionsKey(userID), vals["access"], vals["refresh"], key)
+	_, err = pipe.Exec(context.Background())
+	return err
+}
+
+const sessionPrefix = "session:"
+
+func sessionKey(sid string) string {
+	return sessionPrefix + sid
+}
+
+func unix(s string) time.Time {
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return time.Unix(n, 0).UTC()
+}
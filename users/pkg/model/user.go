@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 // User model
 type User struct {
 	ID       string `json:"id" bson:"_id,omitempty"`
@@ -7,4 +9,37 @@ type User struct {
 	Password string `json:"password"`
 	Email    string `json:"email"`
 	Phone    string `json:"phone"`
+
+	// DisplayName, Bio and AvatarURL make the public profile
+	DisplayName string `json:"display_name" bson:"displayName,omitempty"`
+	Bio         string `json:"bio" bson:"bio,omitempty"`
+	AvatarURL   string `json:"avatar_url" bson:"avatarURL,omitempty"`
+
+	EmailVerified bool `json:"email_verified" bson:"emailVerified"`
+	PhoneVerified bool `json:"phone_verified" bson:"phoneVerified"`
+
+	Roles []string `json:"roles" bson:"roles"`
+
+	TOTPEnabled bool   `json:"totp_enabled" bson:"totpEnabled"`
+	TOTPSecret  string `json:"-" bson:"totpSecret,omitempty"`
+	// TOTPPending is the secret of an enrollment that is not confirmed yet
+	TOTPPending string `json:"-" bson:"totpPending,omitempty"`
+	// TOTPStep is the last time step a code was accepted for, a code works once
+	TOTPStep int64 `json:"-" bson:"totpStep,omitempty"`
+	// RecoveryCodes holds the hashes of the unused recovery codes
+	RecoveryCodes []string `json:"-" bson:"recoveryCodes,omitempty"`
+
+	// Identities are the external accounts the user signs in with
+	Identities []Identity `json:"-" bson:"identities,omitempty"`
+
+	APIKeys []APIKey `json:"-" bson:"apiKeys,omitempty"`
+
+	// DeleteAt is set for an account that asked to be deleted, it is removed then
+	DeleteAt *time.Time `json:"-" bson:"deleteAt,omitempty"`
+}
+
+// Identity is an account of the user at an OpenID provider.
+type Identity struct {
+	Issuer  string `json:"issuer" bson:"issuer"`
+	Subject string `json:"subject" bson:"subject"`
 }
@@ -0,0 +1,312 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jjwt "github.com/dgrijalva/jwt-go"
+	rd "github.com/go-redis/redis"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/keys"
+	"github.com/emadghaffari/kit-blog/users/pkg/redis"
+	"github.com/emadghaffari/kit-blog/users/pkg/tokens"
+)
+
+var (
+	// ErrDisabled is returned when no provider is configured.
+	ErrDisabled = errors.New("oidc login is not configured")
+	// ErrState is returned for an unknown, used or expired state.
+	ErrState = errors.New("invalid or expired oidc state")
+	// ErrIDToken is returned when the id token of the provider does not verify.
+	ErrIDToken = errors.New("invalid oidc id token")
+)
+
+// Identity is who the provider says signed in.
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Provider runs the authorization code flow with PKCE against an OpenID
+// provider found by discovery, e.g. a local mock provider in development.
+type Provider struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	// StateTTL is how long a started login can be finished.
+	StateTTL time.Duration
+	// Skew is the clock difference tolerated when checking id tokens.
+	Skew   time.Duration
+	Client *http.Client
+
+	states states
+	mu     sync.Mutex
+	meta   *metadata
+	keys   *keys.Remote
+}
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// login is what the state of a started login remembers.
+type login struct {
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+}
+
+// New returns a Provider for the client registered at issuer.
+func New(issuer, clientID, clientSecret, redirectURL string) *Provider {
+	return &Provider{
+		Issuer:       strings.TrimSuffix(issuer, "/"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		StateTTL:     10 * time.Minute,
+		Skew:         time.Minute,
+		Client:       &http.Client{Timeout: 10 * time.Second},
+		states:       redisStates{},
+	}
+}
+
+// AuthURL starts a login and returns where to send the browser.
+func (p *Provider) AuthURL(ctx context.Context) (string, error) {
+	if p == nil || p.Issuer == "" {
+		return "", ErrDisabled
+	}
+	meta, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := random()
+	if err != nil {
+		return "", err
+	}
+	l := login{}
+	if l.Verifier, err = random(); err != nil {
+		return "", err
+	}
+	if l.Nonce, err = random(); err != nil {
+		return "", err
+	}
+	if err := p.states.save(ctx, state, l, p.StateTTL); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(l.Verifier))
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", p.ClientID)
+	v.Set("redirect_uri", p.RedirectURL)
+	v.Set("scope", strings.Join(p.Scopes, " "))
+	v.Set("state", state)
+	v.Set("nonce", l.Nonce)
+	v.Set("code_challenge", base64.RawURLEncoding.EncodeToString(sum[:]))
+	v.Set("code_challenge_method", "S256")
+	return meta.AuthorizationEndpoint + "?" + v.Encode(), nil
+}
+
+// Exchange finishes the login of state with the code the provider sent
+// back, and returns the verified identity of the user.
+func (p *Provider) Exchange(ctx context.Context, state, code string) (Identity, error) {
+	if p == nil || p.Issuer == "" {
+		return Identity{}, ErrDisabled
+	}
+	l, err := p.states.take(ctx, state)
+	if err != nil {
+		return Identity{}, err
+	}
+	meta, remote, err := p.discover(ctx)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.RedirectURL)
+	form.Set("code_verifier", l.Verifier)
+	req, err := http.NewRequest(http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Identity{}, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(p.ClientID), url.QueryEscape(p.ClientSecret))
+
+	res, err := p.Client.Do(req)
+	if err != nil {
+		return Identity{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return Identity{}, fmt.Errorf("oidc: token endpoint returned %s", res.Status)
+	}
+	tr := struct {
+		IDToken string `json:"id_token"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&tr); err != nil {
+		return Identity{}, err
+	}
+
+	return p.verify(tr.IDToken, meta.Issuer, l.Nonce, remote)
+}
+
+// idClaims are the claims of an id token this package reads.
+type idClaims struct {
+	Issuer        string          `json:"iss"`
+	Subject       string          `json:"sub"`
+	Audience      tokens.Audience `json:"aud"`
+	ExpiresAt     int64           `json:"exp"`
+	IssuedAt      int64           `json:"iat"`
+	Nonce         string          `json:"nonce"`
+	Email         string          `json:"email"`
+	EmailVerified bool            `json:"email_verified"`
+	Name          string          `json:"name"`
+}
+
+// Valid is checked by verify, with clock skew.
+func (c *idClaims) Valid() error {
+	return nil
+}
+
+func (p *Provider) verify(raw, issuer, nonce string, remote *keys.Remote) (Identity, error) {
+	c := &idClaims{}
+	parser := jjwt.Parser{ValidMethods: []string{jjwt.SigningMethodRS256.Alg()}, SkipClaimsValidation: true}
+	if _, err := parser.ParseWithClaims(raw, c, keys.Keyfunc(remote)); err != nil {
+		return Identity{}, ErrIDToken
+	}
+
+	now := time.Now().Unix()
+	skew := int64(p.Skew / time.Second)
+	switch {
+	case c.Issuer != issuer:
+		return Identity{}, ErrIDToken
+	case !contains(c.Audience, p.ClientID):
+		return Identity{}, ErrIDToken
+	case now > c.ExpiresAt+skew || now < c.IssuedAt-skew:
+		return Identity{}, ErrIDToken
+	case c.Nonce != nonce || c.Subject == "":
+		return Identity{}, ErrIDToken
+	}
+
+	return Identity{
+		Issuer:        c.Issuer,
+		Subject:       c.Subject,
+		Email:         strings.ToLower(c.Email),
+		EmailVerified: c.EmailVerified,
+		Name:          c.Name,
+	}, nil
+}
+
+// states keeps the started logins until their callback.
+type states interface {
+	save(ctx context.Context, state string, l login, ttl time.Duration) error
+	// take returns the login of state and invalidates it, so a callback
+	// can not be replayed.
+	take(ctx context.Context, state string) (login, error)
+}
+
+type redisStates struct{}
+
+func (redisStates) save(ctx context.Context, state string, l login, ttl time.Duration) error {
+	bt, err := json.Marshal(l)
+	if err != nil {
+		return err
+	}
+	return redis.DB.GetDB().Set(ctx, stateKey(state), string(bt), ttl).Err()
+}
+
+func (redisStates) take(ctx context.Context, state string) (login, error) {
+	l := login{}
+	var get *rd.StringCmd
+	_, err := redis.DB.GetDB().TxPipelined(ctx, func(pipe rd.Pipeliner) error {
+		get = pipe.Get(ctx, stateKey(state))
+		pipe.Del(ctx, stateKey(state))
+		return nil
+	})
+	if err == rd.Nil {
+		return l, ErrState
+	}
+	if err != nil {
+		return l, err
+	}
+	if err := json.Unmarshal([]byte(get.Val()), &l); err != nil {
+		return l, ErrState
+	}
+	return l, nil
+}
+
+// discover reads the provider metadata once.
+func (p *Provider) discover(ctx context.Context) (*metadata, *keys.Remote, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, p.keys, nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, p.Issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := p.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("oidc: discovery returned %s", res.Status)
+	}
+	meta := &metadata{}
+	if err := json.NewDecoder(res.Body).Decode(meta); err != nil {
+		return nil, nil, err
+	}
+	if strings.TrimSuffix(meta.Issuer, "/") != p.Issuer {
+		return nil, nil, fmt.Errorf("oidc: discovery issuer %q does not match %q", meta.Issuer, p.Issuer)
+	}
+
+	p.meta, p.keys = meta, keys.NewRemote(meta.JWKSURI)
+	return p.meta, p.keys, nil
+}
+
+func contains(aud tokens.Audience, clientID string) bool {
+	for _, a := range aud {
+		if a == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+func random() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func stateKey(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return "oidc:state:" + base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,254 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	jjwt "github.com/dgrijalva/jwt-go"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/keys"
+)
+
+// memStates keeps the started logins in memory instead of redis.
+type memStates struct {
+	mu     sync.Mutex
+	logins map[string]login
+}
+
+func (m *memStates) save(ctx context.Context, state string, l login, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.logins[state] = l
+	return nil
+}
+
+func (m *memStates) take(ctx context.Context, state string) (login, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	l, ok := m.logins[state]
+	if !ok {
+		return login{}, ErrState
+	}
+	delete(m.logins, state)
+	return l, nil
+}
+
+// grant is what the mock provider remembers of an authorization request.
+type grant struct {
+	challenge string
+	nonce     string
+}
+
+// mockProvider is an OpenID provider serving discovery, a JWKS and a token
+// endpoint that checks PKCE and signs id tokens with key.
+type mockProvider struct {
+	*httptest.Server
+	t   *testing.T
+	key *rsa.PrivateKey
+
+	mu     sync.Mutex
+	grants map[string]grant
+	// nonce replaces the nonce of the id token when set
+	nonce string
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockProvider{t: t, key: key, grants: map[string]grant{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.URL,
+			"authorization_endpoint": m.URL + "/authorize",
+			"token_endpoint":         m.URL + "/token",
+			"jwks_uri":               m.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		set := keys.JWKS{Keys: []keys.JWK{
+			// an EC key next to the RSA one, as real providers publish
+			{Kty: "EC", Use: "sig", Alg: "ES256", Kid: "ec"},
+			keys.FromPublic("rsa", &key.PublicKey),
+		}}
+		json.NewEncoder(w).Encode(set)
+	})
+	mux.HandleFunc("/token", m.token)
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	return m
+}
+
+// authorize plays the browser visiting authURL and the provider sending
+// back a code, and returns the state and the code of the callback.
+func (m *mockProvider) authorize(authURL string) (state, code string) {
+	m.t.Helper()
+	u, err := url.Parse(authURL)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	q := u.Query()
+	if u.Path != "/authorize" || q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" {
+		m.t.Fatalf("unexpected auth url %s", authURL)
+	}
+	if q.Get("client_id") != "blog" || q.Get("redirect_uri") != "http://blog/callback" {
+		m.t.Fatalf("unexpected client in auth url %s", authURL)
+	}
+
+	code = base64.RawURLEncoding.EncodeToString([]byte(q.Get("state")))
+	m.mu.Lock()
+	m.grants[code] = grant{challenge: q.Get("code_challenge"), nonce: q.Get("nonce")}
+	m.mu.Unlock()
+	return q.Get("state"), code
+}
+
+func (m *mockProvider) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	if !ok || id != "blog" || secret != "secret" {
+		http.Error(w, "invalid_client", http.StatusUnauthorized)
+		return
+	}
+	if r.FormValue("grant_type") != "authorization_code" || r.FormValue("redirect_uri") != "http://blog/callback" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	g, ok := m.grants[r.FormValue("code")]
+	delete(m.grants, r.FormValue("code"))
+	nonce := m.nonce
+	m.mu.Unlock()
+	sum := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != g.challenge {
+		http.Error(w, "invalid_grant", http.StatusBadRequest)
+		return
+	}
+	if nonce == "" {
+		nonce = g.nonce
+	}
+
+	now := time.Now().Unix()
+	tok := jjwt.NewWithClaims(jjwt.SigningMethodRS256, jjwt.MapClaims{
+		"iss":            m.URL,
+		"sub":            "user-1",
+		"aud":            "blog",
+		"iat":            now,
+		"exp":            now + 300,
+		"nonce":          nonce,
+		"email":          "User@Example.com",
+		"email_verified": true,
+		"name":           "User",
+	})
+	tok.Header["kid"] = "rsa"
+	raw, err := tok.SignedString(m.key)
+	if err != nil {
+		m.t.Error(err)
+		http.Error(w, "server_error", http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"id_token": raw, "token_type": "Bearer"})
+}
+
+func newTestProvider(m *mockProvider) *Provider {
+	p := New(m.URL, "blog", "secret", "http://blog/callback")
+	p.states = &memStates{logins: map[string]login{}}
+	return p
+}
+
+func TestExchange(t *testing.T) {
+	m := newMockProvider(t)
+	p := newTestProvider(m)
+	ctx := context.Background()
+
+	authURL, err := p.AuthURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, code := m.authorize(authURL)
+
+	id, err := p.Exchange(ctx, state, code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Identity{Issuer: m.URL, Subject: "user-1", Email: "user@example.com", EmailVerified: true, Name: "User"}
+	if id != want {
+		t.Fatalf("identity = %+v, want %+v", id, want)
+	}
+
+	// the callback can not be replayed
+	if _, err := p.Exchange(ctx, state, code); err != ErrState {
+		t.Fatalf("replayed state: err = %v, want %v", err, ErrState)
+	}
+}
+
+func TestExchangeUnknownState(t *testing.T) {
+	m := newMockProvider(t)
+	p := newTestProvider(m)
+
+	if _, err := p.Exchange(context.Background(), "forged", "code"); err != ErrState {
+		t.Fatalf("err = %v, want %v", err, ErrState)
+	}
+}
+
+func TestExchangeWrongVerifier(t *testing.T) {
+	m := newMockProvider(t)
+	p := newTestProvider(m)
+	ctx := context.Background()
+
+	authURL, err := p.AuthURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, code := m.authorize(authURL)
+
+	// a code stolen from the callback is useless without the verifier
+	s := p.states.(*memStates)
+	l := s.logins[state]
+	l.Verifier = "stolen"
+	s.logins[state] = l
+
+	if _, err := p.Exchange(ctx, state, code); err == nil {
+		t.Fatal("exchange with a wrong verifier succeeded")
+	}
+}
+
+func TestExchangeWrongNonce(t *testing.T) {
+	m := newMockProvider(t)
+	m.nonce = "replayed"
+	p := newTestProvider(m)
+	ctx := context.Background()
+
+	authURL, err := p.AuthURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, code := m.authorize(authURL)
+
+	if _, err := p.Exchange(ctx, state, code); err != ErrIDToken {
+		t.Fatalf("err = %v, want %v", err, ErrIDToken)
+	}
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	m := newMockProvider(t)
+	p := New(m.URL+"/other", "blog", "secret", "http://blog/callback")
+	p.states = &memStates{logins: map[string]login{}}
+
+	if _, err := p.AuthURL(context.Background()); err == nil {
+		t.Fatal("discovery accepted the metadata of another issuer")
+	}
+}
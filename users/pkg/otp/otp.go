@@ -0,0 +1,126 @@
+package otp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/redis"
+)
+
+var (
+	// ErrExpired is returned when there is no pending code, or it has expired.
+	ErrExpired = errors.New("verification code expired or not requested")
+	// ErrInvalid is returned for a wrong code while attempts are left.
+	ErrInvalid = errors.New("invalid verification code")
+	// ErrTooManyAttempts is returned once a code has been guessed wrong too often;
+	// the code is dropped and a new one must be requested.
+	ErrTooManyAttempts = errors.New("too many verification attempts")
+)
+
+// Store keeps one pending code per user and purpose in redis. Only a
+// salted hash of the code is stored.
+type Store struct {
+	TTL         time.Duration
+	MaxAttempts int64
+	Digits      int
+}
+
+// New returns a Store for codes with ttl and maxAttempts.
+func New(ttl time.Duration, maxAttempts int64) *Store {
+	return &Store{TTL: ttl, MaxAttempts: maxAttempts, Digits: 6}
+}
+
+// Issue creates a new code for userID and purpose, replacing any pending one,
+// and returns it so the caller can deliver it. target is the address the code
+// is sent to; Verify returns it so the caller knows what was proven.
+func (s *Store) Issue(ctx context.Context, userID, purpose, target string) (string, error) {
+	code, err := s.code()
+	if err != nil {
+		return "", err
+	}
+	salt, err := salt()
+	if err != nil {
+		return "", err
+	}
+
+	key := s.key(userID, purpose)
+	pipe := redis.DB.GetDB().TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, "hash", hash(salt, code), "salt", salt, "target", target, "attempts", 0)
+	pipe.Expire(ctx, key, s.TTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// Verify checks code for userID and purpose. A matching code is consumed and
+// the target it was issued for is returned.
+func (s *Store) Verify(ctx context.Context, userID, purpose, code string) (string, error) {
+	key := s.key(userID, purpose)
+	db := redis.DB.GetDB()
+
+	vals, err := db.HGetAll(ctx, key).Result()
+	if err != nil {
+		return "", err
+	}
+	if len(vals) == 0 {
+		return "", ErrExpired
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hash(vals["salt"], code)), []byte(vals["hash"])) == 1 {
+		// only one caller may consume the code
+		n, err := db.Del(ctx, key).Result()
+		if err != nil {
+			return "", err
+		}
+		if n == 0 {
+			return "", ErrExpired
+		}
+		return vals["target"], nil
+	}
+
+	attempts, err := db.HIncrBy(ctx, key, "attempts", 1).Result()
+	if err != nil {
+		return "", err
+	}
+	if attempts >= s.MaxAttempts {
+		db.Del(ctx, key)
+		return "", ErrTooManyAttempts
+	}
+	return "", ErrInvalid
+}
+
+func (s *Store) key(userID, purpose string) string {
+	return fmt.Sprintf("otp:%s:%s", purpose, userID)
+}
+
+// code returns a uniformly random numeric code of s.Digits digits.
+func (s *Store) code() (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(s.Digits)), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", s.Digits, n), nil
+}
+
+func salt() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hash(salt, code string) string {
+	sum := sha256.Sum256([]byte(salt + code))
+	return hex.EncodeToString(sum[:])
+}
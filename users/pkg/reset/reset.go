@@ -0,0 +1,64 @@
+package reset
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	rd "github.com/go-redis/redis"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/redis"
+)
+
+// ErrInvalid is returned for an unknown, used or expired token.
+var ErrInvalid = errors.New("invalid or expired reset token")
+
+// Tokens issues single-use password reset tokens. Redis keeps only the
+// hash of a token, mapped to the user it resets.
+type Tokens struct {
+	TTL time.Duration
+}
+
+// New returns Tokens valid for ttl.
+func New(ttl time.Duration) *Tokens {
+	return &Tokens{TTL: ttl}
+}
+
+// Issue returns a new token for userID.
+func (t *Tokens) Issue(ctx context.Context, userID string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	if err := redis.DB.GetDB().Set(ctx, key(token), userID, t.TTL).Err(); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Consume returns the user of token and invalidates it, so a token works once.
+func (t *Tokens) Consume(ctx context.Context, token string) (string, error) {
+	var get *rd.StringCmd
+	_, err := redis.DB.GetDB().TxPipelined(ctx, func(pipe rd.Pipeliner) error {
+		get = pipe.Get(ctx, key(token))
+		pipe.Del(ctx, key(token))
+		return nil
+	})
+	if err == rd.Nil {
+		return "", ErrInvalid
+	}
+	if err != nil {
+		return "", err
+	}
+	return get.Val(), nil
+}
+
+func key(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "reset:" + hex.EncodeToString(sum[:])
+}
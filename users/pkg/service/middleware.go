@@ -4,6 +4,8 @@ import (
 	"context"
 
 	log "github.com/go-kit/kit/log"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/model"
 )
 
 // Middleware describes a service middleware.
@@ -23,7 +25,7 @@ func LoggingMiddleware(logger log.Logger) Middleware {
 
 }
 
-func (l loggingMiddleware) Login(ctx context.Context, username string, password string) (s0 string, e1 error) {
+func (l loggingMiddleware) Login(ctx context.Context, username string, password string) (s0 string, challenge string, e1 error) {
 	defer func() {
 		l.logger.Log("method", "Login", "username", username, "password", password, "s0", s0, "e1", e1)
 	}()
@@ -36,9 +38,183 @@ func (l loggingMiddleware) Register(ctx context.Context, username string, passwo
 	return l.next.Register(ctx, username, password, email, phone)
 }
 
-func (l loggingMiddleware) Get(ctx context.Context, id string) (s0, s1, s2 string, e1 error) {
+func (l loggingMiddleware) GetPrivate(ctx context.Context, id string) (s0, s1, s2 string, e1 error) {
+	defer func() {
+		l.logger.Log("method", "GetPrivate", "id", id, "e1", e1)
+	}()
+	return l.next.GetPrivate(ctx, id)
+}
+func (l loggingMiddleware) RequestVerification(ctx context.Context, id string, channel string) (expiresIn int64, err error) {
+	defer func() {
+		l.logger.Log("method", "RequestVerification", "id", id, "channel", channel, "expiresIn", expiresIn, "err", err)
+	}()
+	return l.next.RequestVerification(ctx, id, channel)
+}
+func (l loggingMiddleware) ConfirmVerification(ctx context.Context, id string, channel string, code string) (verified bool, err error) {
+	defer func() {
+		l.logger.Log("method", "ConfirmVerification", "id", id, "channel", channel, "verified", verified, "err", err)
+	}()
+	return l.next.ConfirmVerification(ctx, id, channel, code)
+}
+func (l loggingMiddleware) Authenticate(ctx context.Context, token string) (id string, username string, roles []string, scopes []string, keyID string, err error) {
+	defer func() {
+		l.logger.Log("method", "Authenticate", "id", id, "username", username, "roles", roles, "scopes", scopes, "keyID", keyID, "err", err)
+	}()
+	return l.next.Authenticate(ctx, token)
+}
+func (l loggingMiddleware) RequestPasswordReset(ctx context.Context, email string) (response string, err error) {
+	defer func() {
+		l.logger.Log("method", "RequestPasswordReset", "email", email, "response", response, "err", err)
+	}()
+	return l.next.RequestPasswordReset(ctx, email)
+}
+func (l loggingMiddleware) ResetPassword(ctx context.Context, token string, password string) (response string, err error) {
+	defer func() {
+		l.logger.Log("method", "ResetPassword", "response", response, "err", err)
+	}()
+	return l.next.ResetPassword(ctx, token, password)
+}
+func (l loggingMiddleware) UpdateProfile(ctx context.Context, id string, username string, email string, phone string) (newUsername string, newEmail string, newPhone string, err error) {
+	defer func() {
+		l.logger.Log("method", "UpdateProfile", "id", id, "username", username, "email", email, "phone", phone, "newUsername", newUsername, "newEmail", newEmail, "newPhone", newPhone, "err", err)
+	}()
+	return l.next.UpdateProfile(ctx, id, username, email, phone)
+}
+func (l loggingMiddleware) ChangePassword(ctx context.Context, id string, current string, password string) (response string, err error) {
+	defer func() {
+		l.logger.Log("method", "ChangePassword", "id", id, "response", response, "err", err)
+	}()
+	return l.next.ChangePassword(ctx, id, current, password)
+}
+func (l loggingMiddleware) GrantRole(ctx context.Context, id string, role string) (roles []string, err error) {
+	defer func() {
+		l.logger.Log("method", "GrantRole", "id", id, "role", role, "roles", roles, "err", err)
+	}()
+	return l.next.GrantRole(ctx, id, role)
+}
+func (l loggingMiddleware) RevokeRole(ctx context.Context, id string, role string) (roles []string, err error) {
+	defer func() {
+		l.logger.Log("method", "RevokeRole", "id", id, "role", role, "roles", roles, "err", err)
+	}()
+	return l.next.RevokeRole(ctx, id, role)
+}
+func (l loggingMiddleware) EnrollTOTP(ctx context.Context, id string) (secret string, uri string, err error) {
+	defer func() {
+		l.logger.Log("method", "EnrollTOTP", "id", id, "err", err)
+	}()
+	return l.next.EnrollTOTP(ctx, id)
+}
+func (l loggingMiddleware) ConfirmTOTP(ctx context.Context, id string, code string) (recoveryCodes []string, err error) {
+	defer func() {
+		l.logger.Log("method", "ConfirmTOTP", "id", id, "err", err)
+	}()
+	return l.next.ConfirmTOTP(ctx, id, code)
+}
+func (l loggingMiddleware) VerifySecondFactor(ctx context.Context, challenge string, code string) (accessToken string, refreshToken string, err error) {
+	defer func() {
+		l.logger.Log("method", "VerifySecondFactor", "err", err)
+	}()
+	return l.next.VerifySecondFactor(ctx, challenge, code)
+}
+func (l loggingMiddleware) ListSessions(ctx context.Context, id string) (items []model.Session, err error) {
+	defer func() {
+		l.logger.Log("method", "ListSessions", "id", id, "items", items, "err", err)
+	}()
+	return l.next.ListSessions(ctx, id)
+}
+func (l loggingMiddleware) RevokeSession(ctx context.Context, id string, sessionID string) (response string, err error) {
+	defer func() {
+		l.logger.Log("method", "RevokeSession", "id", id, "sessionID", sessionID, "response", response, "err", err)
+	}()
+	return l.next.RevokeSession(ctx, id, sessionID)
+}
+func (l loggingMiddleware) OIDCLogin(ctx context.Context) (url string, err error) {
+	defer func() {
+		l.logger.Log("method", "OIDCLogin", "url", url, "err", err)
+	}()
+	return l.next.OIDCLogin(ctx)
+}
+func (l loggingMiddleware) OIDCCallback(ctx context.Context, state string, code string) (accessToken string, refreshToken string, challenge string, err error) {
+	defer func() {
+		l.logger.Log("method", "OIDCCallback", "state", state, "err", err)
+	}()
+	return l.next.OIDCCallback(ctx, state, code)
+}
+func (l loggingMiddleware) CreateAPIKey(ctx context.Context, id string, name string, scopes []string, expiresIn int64) (keyID string, key string, err error) {
+	defer func() {
+		l.logger.Log("method", "CreateAPIKey", "id", id, "name", name, "scopes", scopes, "expiresIn", expiresIn, "keyID", keyID, "err", err)
+	}()
+	return l.next.CreateAPIKey(ctx, id, name, scopes, expiresIn)
+}
+func (l loggingMiddleware) ListAPIKeys(ctx context.Context, id string) (items []model.APIKey, err error) {
+	defer func() {
+		l.logger.Log("method", "ListAPIKeys", "id", id, "items", items, "err", err)
+	}()
+	return l.next.ListAPIKeys(ctx, id)
+}
+func (l loggingMiddleware) RevokeAPIKey(ctx context.Context, id string, keyID string) (response string, err error) {
+	defer func() {
+		l.logger.Log("method", "RevokeAPIKey", "id", id, "keyID", keyID, "response", response, "err", err)
+	}()
+	return l.next.RevokeAPIKey(ctx, id, keyID)
+}
+func (l loggingMiddleware) DeleteAccount(ctx context.Context, id string, password string) (deleteAt string, err error) {
+	defer func() {
+		l.logger.Log("method", "DeleteAccount", "id", id, "deleteAt", deleteAt, "err", err)
+	}()
+	return l.next.DeleteAccount(ctx, id, password)
+}
+func (l loggingMiddleware) ExportMyData(ctx context.Context, id string) (jobID string, err error) {
+	defer func() {
+		l.logger.Log("method", "ExportMyData", "id", id, "jobID", jobID, "err", err)
+	}()
+	return l.next.ExportMyData(ctx, id)
+}
+func (l loggingMiddleware) ExportStatus(ctx context.Context, id string, jobID string) (state string, downloadURL string, err error) {
+	defer func() {
+		l.logger.Log("method", "ExportStatus", "id", id, "jobID", jobID, "state", state, "downloadURL", downloadURL, "err", err)
+	}()
+	return l.next.ExportStatus(ctx, id, jobID)
+}
+func (l loggingMiddleware) GetPublicProfile(ctx context.Context, id string) (username string, displayName string, bio string, avatarURL string, joinedAt string, err error) {
+	defer func() {
+		l.logger.Log("method", "GetPublicProfile", "id", id, "username", username, "displayName", displayName, "bio", bio, "avatarURL", avatarURL, "joinedAt", joinedAt, "err", err)
+	}()
+	return l.next.GetPublicProfile(ctx, id)
+}
+func (l loggingMiddleware) UpdatePublicProfile(ctx context.Context, id string, displayName string, bio string, avatarURL string) (response string, err error) {
+	defer func() {
+		l.logger.Log("method", "UpdatePublicProfile", "id", id, "displayName", displayName, "bio", bio, "avatarURL", avatarURL, "response", response, "err", err)
+	}()
+	return l.next.UpdatePublicProfile(ctx, id, displayName, bio, avatarURL)
+}
+func (l loggingMiddleware) Follow(ctx context.Context, id string, authorID string) (response string, err error) {
+	defer func() {
+		l.logger.Log("method", "Follow", "id", id, "authorID", authorID, "response", response, "err", err)
+	}()
+	return l.next.Follow(ctx, id, authorID)
+}
+func (l loggingMiddleware) Unfollow(ctx context.Context, id string, authorID string) (response string, err error) {
+	defer func() {
+		l.logger.Log("method", "Unfollow", "id", id, "authorID", authorID, "response", response, "err", err)
+	}()
+	return l.next.Unfollow(ctx, id, authorID)
+}
+func (l loggingMiddleware) ListFollowers(ctx context.Context, id string, limit int64, cursor string) (items []model.Follow, total int64, next string, err error) {
+	defer func() {
+		l.logger.Log("method", "ListFollowers", "id", id, "limit", limit, "cursor", cursor, "total", total, "next", next, "err", err)
+	}()
+	return l.next.ListFollowers(ctx, id, limit, cursor)
+}
+func (l loggingMiddleware) ListFollowing(ctx context.Context, id string, limit int64, cursor string) (items []model.Follow, total int64, next string, err error) {
+	defer func() {
+		l.logger.Log("method", "ListFollowing", "id", id, "limit", limit, "cursor", cursor, "total", total, "next", next, "err", err)
+	}()
+	return l.next.ListFollowing(ctx, id, limit, cursor)
+}
+func (l loggingMiddleware) CancelDeletion(ctx context.Context, id string) (response string, err error) {
 	defer func() {
-		l.logger.Log("method", "Get", "id", id, "s0", s0, "s1", s1, "s2", s2, "e1", e1)
+		l.logger.Log("method", "CancelDeletion", "id", id, "response", response, "err", err)
 	}()
-	return l.next.Get(ctx, id)
+	return l.next.CancelDeletion(ctx, id)
 }
@@ -0,0 +1,128 @@
+package tokens
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	jjwt "github.com/dgrijalva/jwt-go"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/keys"
+)
+
+var (
+	// ErrExpired is returned for a token past its exp.
+	ErrExpired = errors.New("token is expired")
+	// ErrNotYetValid is returned for a token before its nbf or iat.
+	ErrNotYetValid = errors.New("token is not valid yet")
+	// ErrIssuer is returned for a token of another issuer.
+	ErrIssuer = errors.New("token issuer is not accepted")
+	// ErrAudience is returned for a token meant for somebody else.
+	ErrAudience = errors.New("token audience is not accepted")
+)
+
+// Audience is the aud claim, a single string or a list of them.
+type Audience []string
+
+// MarshalJSON writes a single audience as a plain string.
+func (a Audience) MarshalJSON() ([]byte, error) {
+	if len(a) == 1 {
+		return json.Marshal(a[0])
+	}
+	return json.Marshal([]string(a))
+}
+
+// UnmarshalJSON accepts both forms of the aud claim.
+func (a *Audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = Audience{s}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+// Claims of a users access token.
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  Audience `json:"aud"`
+	ID        string   `json:"jti"`
+	IssuedAt  int64    `json:"iat"`
+	NotBefore int64    `json:"nbf"`
+	ExpiresAt int64    `json:"exp"`
+
+	Username string   `json:"username"`
+	Roles    []string `json:"roles"`
+	// UUID keys the session of the token in redis
+	UUID string `json:"uuid"`
+	// Session is the id the user sees the session by
+	Session    string `json:"sid,omitempty"`
+	Authorized bool   `json:"authorized"`
+}
+
+// Valid is checked by Parser.Parse, jwt-go's own check has no clock skew.
+func (c *Claims) Valid() error {
+	return nil
+}
+
+// Parser checks the signature and the claims of access tokens.
+type Parser struct {
+	Keys     keys.PublicKeys
+	Issuer   string
+	Audience string
+	// Skew is how far the clocks of the issuer and the parser may be apart.
+	Skew time.Duration
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// Parse verifies raw and returns its claims.
+func (p *Parser) Parse(raw string) (*Claims, error) {
+	claims := &Claims{}
+	parser := jjwt.Parser{ValidMethods: []string{jjwt.SigningMethodRS256.Alg()}, SkipClaimsValidation: true}
+	if _, err := parser.ParseWithClaims(raw, claims, keys.Keyfunc(p.Keys)); err != nil {
+		return nil, err
+	}
+	if err := p.validate(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (p *Parser) validate(c *Claims) error {
+	now := time.Now
+	if p.Now != nil {
+		now = p.Now
+	}
+	t := now().Unix()
+	skew := int64(p.Skew / time.Second)
+
+	if c.ExpiresAt == 0 || t > c.ExpiresAt+skew {
+		return ErrExpired
+	}
+	if t < c.NotBefore-skew || t < c.IssuedAt-skew {
+		return ErrNotYetValid
+	}
+	if p.Issuer != "" && c.Issuer != p.Issuer {
+		return ErrIssuer
+	}
+	if p.Audience != "" && !c.Audience.has(p.Audience) {
+		return ErrAudience
+	}
+	return nil
+}
+
+func (a Audience) has(aud string) bool {
+	for _, v := range a {
+		if v == aud {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package totp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/emadghaffari/kit-blog/users/pkg/redis"
+)
+
+var (
+	// ErrChallenge is returned for an unknown, used or expired challenge.
+	ErrChallenge = errors.New("invalid or expired login challenge")
+	// ErrInvalid is returned for a wrong code while attempts are left.
+	ErrInvalid = errors.New("invalid second factor code")
+	// ErrTooManyAttempts is returned once a challenge has been guessed wrong
+	// too often; the password has to be given again.
+	ErrTooManyAttempts = errors.New("too many second factor attempts")
+)
+
+// Challenges are the short-lived tokens Login returns when a password was
+// right but a second factor is still missing. Redis keeps only their hash.
+type Challenges struct {
+	TTL         time.Duration
+	MaxAttempts int64
+}
+
+// NewChallenges returns Challenges valid for ttl and maxAttempts codes.
+func NewChallenges(ttl time.Duration, maxAttempts int64) *Challenges {
+	return &Challenges{TTL: ttl, MaxAttempts: maxAttempts}
+}
+
+// Issue returns a new challenge for userID.
+func (c *Challenges) Issue(ctx context.Context, userID string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	pipe := redis.DB.GetDB().TxPipeline()
+	pipe.HSet(ctx, key(token), "userID", userID, "attempts", 0)
+	pipe.Expire(ctx, key(token), c.TTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// User returns the user token was issued for.
+func (c *Challenges) User(ctx context.Context, token string) (string, error) {
+	userID, err := redis.DB.GetDB().HGet(ctx, key(token), "userID").Result()
+	if err != nil || userID == "" {
+		return "", ErrChallenge
+	}
+	return userID, nil
+}
+
+// Fail counts a wrong code for token and drops it after MaxAttempts.
+func (c *Challenges) Fail(ctx context.Context, token string) error {
+	db := redis.DB.GetDB()
+	pipe := db.TxPipeline()
+	incr := pipe.HIncrBy(ctx, key(token), "attempts", 1)
+	exists := pipe.HExists(ctx, key(token), "userID")
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+	// the challenge expired meanwhile, do not keep the counter around
+	if !exists.Val() {
+		db.Del(ctx, key(token))
+		return ErrChallenge
+	}
+	if incr.Val() >= c.MaxAttempts {
+		db.Del(ctx, key(token))
+		return ErrTooManyAttempts
+	}
+	return ErrInvalid
+}
+
+// Consume invalidates token, only one caller gets to use it.
+func (c *Challenges) Consume(ctx context.Context, token string) error {
+	n, err := redis.DB.GetDB().Del(ctx, key(token)).Result()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrChallenge
+	}
+	return nil
+}
+
+func key(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "mfa:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,99 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Codes are the RFC 6238 defaults every authenticator app supports.
+const (
+	Digits = 6
+	Period = 30 * time.Second
+	// Skew is how many periods before and after now a code is accepted,
+	// for clocks that drift a little.
+	Skew = 1
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Generate returns a new secret for account and the otpauth URI to show as
+// a QR code.
+func Generate(issuer, account string) (secret, uri string, err error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret = encoding.EncodeToString(b)
+
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(int(Period/time.Second)))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return secret, u.String(), nil
+}
+
+// Validate checks code against secret at now and returns the time step it
+// matched, so callers can refuse a code that was already used.
+func Validate(secret, code string, now time.Time) (int64, bool) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != Digits {
+		return 0, false
+	}
+	step := now.Unix() / int64(Period/time.Second)
+	for i := int64(-Skew); i <= Skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(generate(key, step+i)), []byte(code)) == 1 {
+			return step + i, true
+		}
+	}
+	return 0, false
+}
+
+func generate(key []byte, step int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
+
+// RecoveryCodes returns n single-use codes to sign in without the app.
+func RecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		s := strings.ToLower(encoding.EncodeToString(b))
+		codes[i] = s[:4] + "-" + s[4:]
+	}
+	return codes, nil
+}
+
+// Hash returns what is stored for a recovery code.
+func Hash(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}